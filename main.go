@@ -46,11 +46,29 @@ func main() {
 
 	tools.RegisterJiraIssueTool(mcpServer)
 	tools.RegisterJiraSearchTool(mcpServer)
+	tools.RegisterJiraFilterTool(mcpServer)
+	tools.RegisterJiraJQLTool(mcpServer)
+	tools.RegisterJiraFindTool(mcpServer)
+	tools.RegisterJiraProjectTool(mcpServer)
+	tools.RegisterJiraVersionTool(mcpServer)
+	tools.RegisterJiraReleaseNotesTool(mcpServer)
+	tools.RegisterJiraComponentTool(mcpServer)
+	tools.RegisterJiraBoardTool(mcpServer)
 	tools.RegisterJiraSprintTool(mcpServer)
+	tools.RegisterJiraBacklogTool(mcpServer)
+	tools.RegisterJiraReportTool(mcpServer)
+	tools.RegisterJiraEpicTool(mcpServer)
+	tools.RegisterJiraFlowTool(mcpServer)
 	tools.RegisterJiraStatusTool(mcpServer)
+	tools.RegisterJiraWorkflowTool(mcpServer)
+	tools.RegisterJiraMetadataTool(mcpServer)
 	tools.RegisterJiraTransitionTool(mcpServer)
 	tools.RegisterJiraWorklogTool(mcpServer)
 	tools.RegisterJiraCommentTools(mcpServer)
+	tools.RegisterJiraAssignTool(mcpServer)
+	tools.RegisterJiraWatcherTool(mcpServer)
+	tools.RegisterJiraRemoteLinkTool(mcpServer)
+	tools.RegisterJiraLabelTool(mcpServer)
 
 	if *ssePort != "" {
 		sseServer := server.NewSSEServer(mcpServer)
@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// intArgument reads an optional non-negative integer argument passed as a string
+// (or a JSON number), returning defaultValue when it is absent.
+func intArgument(request mcp.CallToolRequest, name string, defaultValue int) (int, error) {
+	switch value := request.Params.Arguments[name].(type) {
+	case nil:
+		return defaultValue, nil
+	case float64:
+		if value < 0 {
+			return 0, fmt.Errorf("invalid %s: %v", name, value)
+		}
+		return int(value), nil
+	case string:
+		if value == "" {
+			return defaultValue, nil
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return 0, fmt.Errorf("invalid %s: %s", name, value)
+		}
+		return parsed, nil
+	default:
+		return 0, fmt.Errorf("invalid %s: %v", name, value)
+	}
+}
+
+// positiveIntArgument reads an optional integer argument like intArgument,
+// rejecting 0 for counts and limits that need at least one item.
+func positiveIntArgument(request mcp.CallToolRequest, name string, defaultValue int) (int, error) {
+	value, err := intArgument(request, name, defaultValue)
+	if err != nil {
+		return 0, err
+	}
+	if value < 1 {
+		return 0, fmt.Errorf("%s must be at least 1", name)
+	}
+	return value, nil
+}
+
+// splitList splits a comma-separated argument into trimmed, non-empty items.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
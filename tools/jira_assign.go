@@ -0,0 +1,87 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraAssignTool(s *server.MCPServer) {
+	jiraAssignIssueTool := mcp.NewTool("jira_assign_issue",
+		mcp.WithDescription("Change the assignee of a Jira issue. Accepts an account ID, an email, a display name, \"me\" for the authenticated user, \"unassigned\" to clear the assignee, or \"default\" to use the project's default assignee"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+		mcp.WithString("assignee", mcp.Required(), mcp.Description("Account ID, email, display name, \"me\", \"unassigned\" or \"default\"")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraAssignIssueTool, util.ErrorGuard(jiraAssignIssueHandler))
+	}
+}
+
+func jiraAssignIssueHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok || issueKey == "" {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	assignee, ok := request.Params.Arguments["assignee"].(string)
+	if !ok || strings.TrimSpace(assignee) == "" {
+		return nil, fmt.Errorf("assignee argument is required")
+	}
+
+	// A null account ID clears the assignee, "-1" lets Jira pick the project's default.
+	var accountID interface{}
+	switch strings.ToLower(strings.TrimSpace(assignee)) {
+	case "unassigned", "none":
+		accountID = nil
+	case "default", "auto", "automatic":
+		accountID = "-1"
+	default:
+		user, err := resolveUser(ctx, assignee)
+		if err != nil {
+			return nil, err
+		}
+		accountID = user.AccountID
+	}
+
+	assignRequest, err := client.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/2/issue/%s/assignee", issueKey), "", map[string]interface{}{"accountId": accountID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create assign request: %v", err)
+	}
+
+	response, err := client.Call(assignRequest, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to assign issue: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to assign issue: %v", err)
+	}
+
+	// Read the assignee back so "default" reports who Jira actually picked.
+	issue, response, err := client.Issue.Get(ctx, issueKey, []string{"assignee"}, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("issue was assigned but could not be reloaded: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("issue was assigned but could not be reloaded: %v", err)
+	}
+
+	if issue.Fields.Assignee == nil {
+		return mcp.NewToolResultText(fmt.Sprintf("Issue %s is now unassigned", issueKey)), nil
+	}
+
+	result := fmt.Sprintf("Issue assigned successfully!\nIssue: %s\nAssignee: %s\nAccount ID: %s",
+		issueKey,
+		formatUser(issue.Fields.Assignee),
+		issue.Fields.Assignee.AccountID,
+	)
+
+	return mcp.NewToolResultText(result), nil
+}
@@ -0,0 +1,321 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+// agileBatchSize is the maximum number of issues the agile API accepts in a
+// single move or rank request.
+const agileBatchSize = 50
+
+func RegisterJiraBacklogTool(s *server.MCPServer) {
+	jiraMoveToSprintTool := mcp.NewTool("jira_move_to_sprint",
+		mcp.WithDescription("Move issues into a future or active sprint"),
+		mcp.WithString("issue_keys", mcp.Required(), mcp.Description("Comma-separated issue keys to move (e.g., KP-1,KP-2)")),
+		mcp.WithString("sprint_id", mcp.Required(), mcp.Description("Numeric ID of the target sprint, from jira_list_sprints")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraMoveToSprintTool, util.ErrorGuard(jiraMoveToSprintHandler))
+	}
+
+	jiraMoveToBacklogTool := mcp.NewTool("jira_move_to_backlog",
+		mcp.WithDescription("Move issues out of their sprints and back into the backlog"),
+		mcp.WithString("issue_keys", mcp.Required(), mcp.Description("Comma-separated issue keys to move (e.g., KP-1,KP-2)")),
+		mcp.WithString("board_id", mcp.Description("Move into this board's backlog. Only needed for boards without sprints (kanban backlogs)")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraMoveToBacklogTool, util.ErrorGuard(jiraMoveToBacklogHandler))
+	}
+
+	jiraRankIssuesTool := mcp.NewTool("jira_rank_issues",
+		mcp.WithDescription("Reorder issues in the backlog by ranking them before or after another issue. The issues keep the order given"),
+		mcp.WithString("issue_keys", mcp.Required(), mcp.Description("Comma-separated issue keys to rank, in the desired order (e.g., KP-5,KP-3)")),
+		mcp.WithString("rank_before_issue", mcp.Description("Place the issues directly before this issue")),
+		mcp.WithString("rank_after_issue", mcp.Description("Place the issues directly after this issue")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraRankIssuesTool, util.ErrorGuard(jiraRankIssuesHandler))
+	}
+
+	jiraGetBacklogTool := mcp.NewTool("jira_get_backlog",
+		mcp.WithDescription("List a board's backlog issues in rank order (top of the backlog first), with estimate and epic"),
+		mcp.WithString("board_id", mcp.Required(), mcp.Description("Numeric ID of the Jira board, from jira_list_boards")),
+		mcp.WithString("jql", mcp.Description("Optional JQL to narrow the backlog (e.g., 'issuetype = Bug AND labels = needs-triage')")),
+		mcp.WithString("start_at", mcp.Description("Rank position of the first issue to return, for pagination (default: 0)")),
+		mcp.WithString("max_results", mcp.Description("Maximum number of issues to return (default: 50)")),
+	)
+	s.AddTool(jiraGetBacklogTool, util.ErrorGuard(jiraGetBacklogHandler))
+}
+
+func jiraMoveToSprintHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	issueKeys, err := issueKeysArgument(request)
+	if err != nil {
+		return nil, err
+	}
+
+	sprintIDStr, ok := request.Params.Arguments["sprint_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("sprint_id argument is required")
+	}
+
+	sprintID, err := strconv.Atoi(sprintIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sprint_id: %v", err)
+	}
+
+	for start := 0; start < len(issueKeys); start += agileBatchSize {
+		batch := issueKeys[start:min(start+agileBatchSize, len(issueKeys))]
+
+		response, err := services.AgileClient().Sprint.Move(ctx, sprintID, &models.SprintMovePayloadScheme{Issues: batch})
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to move issues to sprint: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to move issues to sprint: %v", err)
+		}
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Moved %d issues to sprint %d: %s", len(issueKeys), sprintID, strings.Join(issueKeys, ", "))), nil
+}
+
+func jiraMoveToBacklogHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	issueKeys, err := issueKeysArgument(request)
+	if err != nil {
+		return nil, err
+	}
+
+	boardID := 0
+	if boardIDStr, ok := request.Params.Arguments["board_id"].(string); ok && boardIDStr != "" {
+		boardID, err = strconv.Atoi(boardIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid board_id: %v", err)
+		}
+	}
+
+	for start := 0; start < len(issueKeys); start += agileBatchSize {
+		batch := issueKeys[start:min(start+agileBatchSize, len(issueKeys))]
+
+		var response *models.ResponseScheme
+		if boardID != 0 {
+			response, err = services.AgileClient().Backlog.MoveTo(ctx, boardID, &models.BoardBacklogPayloadScheme{Issues: batch})
+		} else {
+			response, err = services.AgileClient().Backlog.Move(ctx, batch)
+		}
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to move issues to backlog: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to move issues to backlog: %v", err)
+		}
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Moved %d issues to the backlog: %s", len(issueKeys), strings.Join(issueKeys, ", "))), nil
+}
+
+func jiraGetBacklogHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	boardIDStr, ok := request.Params.Arguments["board_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("board_id argument is required")
+	}
+
+	boardID, err := strconv.Atoi(boardIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid board_id: %v", err)
+	}
+
+	startAt, err := intArgument(request, "start_at", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults, err := positiveIntArgument(request, "max_results", 50)
+	if err != nil {
+		return nil, err
+	}
+
+	jql, _ := request.Params.Arguments["jql"].(string)
+
+	config, err := getBoardConfiguration(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	flaggedField, err := findFieldID(ctx, "Flagged")
+	if err != nil {
+		return nil, err
+	}
+
+	epicLinkField, err := findFieldID(ctx, "Epic Link")
+	if err != nil {
+		return nil, err
+	}
+
+	estimationField := boardEstimationField(config)
+	fields := boardFields(estimationField, flaggedField, "parent")
+	if epicLinkField != "" {
+		fields = append(fields, epicLinkField)
+	}
+
+	page, response, err := services.AgileClient().Board.Backlog(ctx, boardID, &models.IssueOptionScheme{JQL: jql, Fields: fields}, startAt, maxResults)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get backlog: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get backlog: %v", err)
+	}
+
+	if len(page.Issues) == 0 {
+		return mcp.NewToolResultText("No backlog issues found for this board."), nil
+	}
+
+	// Company-managed projects may still use the legacy Epic Link field instead of parent.
+	epicLinks := map[string]string{}
+	if epicLinkField != "" {
+		if values, err := models.ParseStringCustomFields(response.Bytes, epicLinkField); err == nil {
+			epicLinks = values
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Board: %s (ID: %d)\nEstimation: %s\n\n", config.Name, config.ID, boardEstimationName(config)))
+
+	var total float64
+	for index, issue := range estimatedIssuesFromBody(response.Bytes, page.Issues, estimationField, flaggedField) {
+		total += issue.Estimate
+
+		line := fmt.Sprintf("%d. %s", startAt+index+1, strings.TrimPrefix(formatSprintIssue(issue, estimationField), "- "))
+		if epic, ok := epicLinks[issue.Issue.Key]; ok && epic != "" {
+			line += " | Epic: " + epic
+		} else if parent := issue.Issue.Fields.Parent; parent != nil && (issue.Issue.Fields.IssueType == nil || !issue.Issue.Fields.IssueType.Subtask) {
+			line += " | Epic: " + parent.Key
+			if parent.Fields != nil && parent.Fields.Summary != "" {
+				line += fmt.Sprintf(" (%s)", parent.Fields.Summary)
+			}
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("\nShowing %d-%d of %d backlog issues", startAt+1, startAt+len(page.Issues), page.Total))
+	if estimationField != "" {
+		sb.WriteString(fmt.Sprintf(" (%s on this page)", formatEstimate(estimationField, total)))
+	}
+	if startAt+len(page.Issues) < page.Total {
+		sb.WriteString(fmt.Sprintf(", use start_at=%d for the next page", startAt+len(page.Issues)))
+	}
+	sb.WriteString("\n")
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// rankResultScheme is the per-issue outcome Jira returns (HTTP 207) when only
+// some issues of a rank request could be ranked.
+type rankResultScheme struct {
+	Entries []struct {
+		IssueKey string   `json:"issueKey"`
+		Status   int      `json:"status"`
+		Errors   []string `json:"errors"`
+	} `json:"entries"`
+}
+
+func jiraRankIssuesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.AgileClient()
+
+	issueKeys, err := issueKeysArgument(request)
+	if err != nil {
+		return nil, err
+	}
+
+	rankBefore, _ := request.Params.Arguments["rank_before_issue"].(string)
+	rankAfter, _ := request.Params.Arguments["rank_after_issue"].(string)
+	if (rankBefore == "") == (rankAfter == "") {
+		return nil, fmt.Errorf("exactly one of rank_before_issue or rank_after_issue is required")
+	}
+
+	// Ranking each batch after the last issue ranked so far keeps the order of
+	// long lists. Until one succeeds, batches use the requested anchor.
+	var ranked, failures []string
+	for start := 0; start < len(issueKeys); start += agileBatchSize {
+		batch := issueKeys[start:min(start+agileBatchSize, len(issueKeys))]
+
+		payload := &models.BoardBacklogPayloadScheme{Issues: batch}
+		if len(ranked) > 0 {
+			payload.RankAfterIssue = ranked[len(ranked)-1]
+		} else if rankBefore != "" {
+			payload.RankBeforeIssue = rankBefore
+		} else {
+			payload.RankAfterIssue = rankAfter
+		}
+
+		rankRequest, err := client.NewRequest(ctx, http.MethodPut, "rest/agile/1.0/issue/rank", "", payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create rank request: %v", err)
+		}
+
+		// A full success is an empty 204, so the body is only decoded for partial results.
+		response, err := client.Call(rankRequest, nil)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to rank issues: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to rank issues: %v", err)
+		}
+
+		result := new(rankResultScheme)
+		if response.Code == http.StatusMultiStatus {
+			if err := json.Unmarshal(response.Bytes.Bytes(), result); err != nil {
+				return nil, fmt.Errorf("failed to decode rank result: %v", err)
+			}
+		}
+
+		failed := map[string]bool{}
+		for _, entry := range result.Entries {
+			if len(entry.Errors) > 0 {
+				failed[entry.IssueKey] = true
+				failures = append(failures, fmt.Sprintf("%s: %s", entry.IssueKey, strings.Join(entry.Errors, "; ")))
+			}
+		}
+		for _, key := range batch {
+			if !failed[key] {
+				ranked = append(ranked, key)
+			}
+		}
+	}
+
+	anchor := "before " + rankBefore
+	if rankAfter != "" {
+		anchor = "after " + rankAfter
+	}
+
+	result := fmt.Sprintf("Ranked %d issues %s: %s", len(ranked), anchor, strings.Join(ranked, ", "))
+	if len(failures) > 0 {
+		result += "\nFailed:\n- " + strings.Join(failures, "\n- ")
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+// issueKeysArgument reads the comma-separated issue_keys argument.
+func issueKeysArgument(request mcp.CallToolRequest) ([]string, error) {
+	issueKeysArg, ok := request.Params.Arguments["issue_keys"].(string)
+	if !ok {
+		return nil, fmt.Errorf("issue_keys argument is required")
+	}
+
+	issueKeys := splitList(issueKeysArg)
+	if len(issueKeys) == 0 {
+		return nil, fmt.Errorf("issue_keys argument is required")
+	}
+
+	return issueKeys, nil
+}
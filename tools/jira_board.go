@@ -0,0 +1,177 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraBoardTool(s *server.MCPServer) {
+	jiraListBoardsTool := mcp.NewTool("jira_list_boards",
+		mcp.WithDescription("Find Jira agile boards by project, name or type. Returns board IDs for use with the sprint and backlog tools"),
+		mcp.WithString("project_key", mcp.Description("Only boards that include this project (e.g., KP, PROJ)")),
+		mcp.WithString("name", mcp.Description("Only boards whose name contains this text")),
+		mcp.WithString("type", mcp.Description("Only boards of this type"), mcp.Enum("scrum", "kanban", "simple")),
+		mcp.WithString("start_at", mcp.Description("Index of the first board to return, for pagination (default: 0)")),
+		mcp.WithString("max_results", mcp.Description("Maximum number of boards to return (default: 50)")),
+	)
+	s.AddTool(jiraListBoardsTool, util.ErrorGuard(jiraListBoardsHandler))
+
+	jiraGetBoardTool := mcp.NewTool("jira_get_board",
+		mcp.WithDescription("Retrieve a Jira board's configuration: columns and their status mapping, estimation field, and the filter JQL that defines the board"),
+		mcp.WithString("board_id", mcp.Required(), mcp.Description("Numeric ID of the Jira board, from jira_list_boards")),
+	)
+	s.AddTool(jiraGetBoardTool, util.ErrorGuard(jiraGetBoardHandler))
+}
+
+func jiraListBoardsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	startAt, err := intArgument(request, "start_at", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults, err := positiveIntArgument(request, "max_results", 50)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &models.GetBoardsOptions{}
+	if projectKey, ok := request.Params.Arguments["project_key"].(string); ok {
+		options.ProjectKeyOrID = projectKey
+	}
+	if name, ok := request.Params.Arguments["name"].(string); ok {
+		options.BoardName = name
+	}
+	if boardType, ok := request.Params.Arguments["type"].(string); ok {
+		options.BoardType = boardType
+	}
+
+	boards, response, err := services.AgileClient().Board.Gets(ctx, options, startAt, maxResults)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get boards: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get boards: %v", err)
+	}
+
+	if len(boards.Values) == 0 {
+		return mcp.NewToolResultText("No boards found matching the criteria."), nil
+	}
+
+	var sb strings.Builder
+	for _, board := range boards.Values {
+		sb.WriteString(fmt.Sprintf("ID: %d\nName: %s\nType: %s\n", board.ID, board.Name, board.Type))
+		if board.Location != nil && board.Location.ProjectKey != "" {
+			sb.WriteString(fmt.Sprintf("Project: %s (%s)\n", board.Location.ProjectKey, board.Location.ProjectName))
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("Showing %d-%d of %d boards", startAt+1, startAt+len(boards.Values), boards.Total))
+	if !boards.IsLast {
+		sb.WriteString(fmt.Sprintf(", use start_at=%d for the next page", startAt+len(boards.Values)))
+	}
+	sb.WriteString("\n")
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func jiraGetBoardHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	boardIDStr, ok := request.Params.Arguments["board_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("board_id argument is required")
+	}
+
+	boardID, err := strconv.Atoi(boardIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid board_id: %v", err)
+	}
+
+	config, err := getBoardConfiguration(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := getStatusDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("ID: %d\nName: %s\nType: %s\n", config.ID, config.Name, config.Type))
+	if config.Location != nil && config.Location.ProjectKey != "" {
+		sb.WriteString(fmt.Sprintf("Project: %s (%s)\n", config.Location.ProjectKey, config.Location.ProjectName))
+	}
+
+	if config.Filter != nil {
+		filterID, err := strconv.Atoi(config.Filter.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid board filter id %q: %v", config.Filter.ID, err)
+		}
+
+		filter, response, err := services.JiraClient().Filter.Get(ctx, filterID, nil)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get board filter: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get board filter: %v", err)
+		}
+
+		sb.WriteString(fmt.Sprintf("Filter: %s (ID: %s)\nFilter JQL: %s\n", filter.Name, filter.ID, filter.Jql))
+	}
+
+	sb.WriteString(fmt.Sprintf("Estimation: %s\n", boardEstimationName(config)))
+	if config.Ranking != nil && config.Ranking.RankCustomFieldID != 0 {
+		sb.WriteString(fmt.Sprintf("Rank Field: customfield_%d\n", config.Ranking.RankCustomFieldID))
+	}
+
+	if config.ColumnConfig != nil {
+		sb.WriteString("\nColumns:\n")
+		for _, column := range config.ColumnConfig.Columns {
+			var names []string
+			for _, status := range column.Statuses {
+				name := "Unknown"
+				if detail, ok := statuses[status.ID]; ok {
+					name = detail.Name
+				}
+				names = append(names, fmt.Sprintf("%s (%s)", name, status.ID))
+			}
+			if len(names) == 0 {
+				names = append(names, "no statuses mapped")
+			}
+			sb.WriteString(fmt.Sprintf("- %s: %s\n", column.Name, strings.Join(names, ", ")))
+		}
+		if config.ColumnConfig.ConstraintType != "" && config.ColumnConfig.ConstraintType != "none" {
+			sb.WriteString(fmt.Sprintf("Column Constraint: %s\n", config.ColumnConfig.ConstraintType))
+		}
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// getBoardConfiguration loads a board's columns, estimation and filter settings.
+func getBoardConfiguration(ctx context.Context, boardID int) (*models.BoardConfigurationScheme, error) {
+	config, response, err := services.AgileClient().Board.Configuration(ctx, boardID)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get board configuration: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get board configuration: %v", err)
+	}
+	return config, nil
+}
+
+// boardEstimationName describes the estimation statistic configured on a board.
+func boardEstimationName(config *models.BoardConfigurationScheme) string {
+	if config.Estimation == nil || config.Estimation.Field == nil {
+		return "None"
+	}
+	return fmt.Sprintf("%s (%s)", config.Estimation.Field.DisplayName, config.Estimation.Field.FieldID)
+}
@@ -0,0 +1,204 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+)
+
+// jiraTimeLayout is the timestamp format used by issue fields and changelogs.
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// parseJiraTime parses an issue or changelog timestamp, returning the zero
+// time for empty or malformed values.
+func parseJiraTime(value string) time.Time {
+	parsed, err := time.Parse(jiraTimeLayout, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
+
+// fieldChange is one change of a single field, taken from an issue changelog.
+type fieldChange struct {
+	At         time.Time
+	From       string
+	FromString string
+	To         string
+	ToString   string
+}
+
+// fieldHistory is the chronological list of changes of one field on one issue.
+type fieldHistory []fieldChange
+
+// changelogHistory extracts the changes of a field from an issue changelog,
+// oldest first. Items are matched on field ID and, for changelogs that omit
+// it, on the field's display name.
+func changelogHistory(issue *models.IssueSchemeV2, fieldID, fieldName string) fieldHistory {
+	if issue.Changelog == nil {
+		return nil
+	}
+
+	var history fieldHistory
+	for _, entry := range issue.Changelog.Histories {
+		at := parseJiraTime(entry.Created)
+		for _, item := range entry.Items {
+			if (fieldID != "" && item.FieldID == fieldID) || (fieldName != "" && strings.EqualFold(item.Field, fieldName)) {
+				history = append(history, fieldChange{At: at, From: item.From, FromString: item.FromString, To: item.To, ToString: item.ToString})
+			}
+		}
+	}
+
+	sort.SliceStable(history, func(i, j int) bool { return history[i].At.Before(history[j].At) })
+	return history
+}
+
+// valueAt returns the raw value and display string the field had at the given
+// time. Before the first change that is the change's "from" value; without
+// any change it is the current value.
+func (h fieldHistory) valueAt(at time.Time, current, currentString string) (string, string) {
+	if len(h) == 0 {
+		return current, currentString
+	}
+
+	value, valueString := h[0].From, h[0].FromString
+	for _, change := range h {
+		if change.At.After(at) {
+			break
+		}
+		value, valueString = change.To, change.ToString
+	}
+	return value, valueString
+}
+
+// between returns the changes made in the half-open interval (from, to].
+func (h fieldHistory) between(from, to time.Time) fieldHistory {
+	var changes fieldHistory
+	for _, change := range h {
+		if change.At.After(from) && !change.At.After(to) {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// parseEstimate reads a numeric estimate from a changelog value, which holds
+// seconds for time tracking fields and the number itself for story points.
+func parseEstimate(value, valueString string) float64 {
+	for _, candidate := range []string{value, valueString} {
+		if parsed, err := strconv.ParseFloat(strings.TrimSpace(candidate), 64); err == nil {
+			return parsed
+		}
+	}
+	return 0
+}
+
+// containsSprint reports whether a Sprint field changelog value, a
+// comma-separated list of sprint IDs, includes the given sprint.
+func containsSprint(value string, sprintID int) bool {
+	for _, id := range strings.Split(value, ",") {
+		if strings.TrimSpace(id) == strconv.Itoa(sprintID) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchIssuesWithChangelog runs a JQL search with the changelog expanded and
+// returns every matching issue with its estimate.
+func searchIssuesWithChangelog(ctx context.Context, jql string, fields []string, estimationField string) ([]*estimatedIssue, error) {
+	return searchAllIssues(ctx, jql, fields, []string{"changelog"}, estimationField)
+}
+
+// searchAllIssues pages through every issue matching a JQL query and pairs
+// each with its value of estimationField, if given. When the changelog is
+// expanded, changelogs truncated by the search API are completed from the
+// issue changelog endpoint.
+func searchAllIssues(ctx context.Context, jql string, fields, expand []string, estimationField string) ([]*estimatedIssue, error) {
+	client := services.JiraClient()
+
+	var issues []*estimatedIssue
+	for startAt := 0; ; {
+		page, response, err := client.Issue.Search.Post(ctx, jql, fields, expand, startAt, 100, "")
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to search issues: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to search issues: %v", err)
+		}
+
+		for _, issue := range page.Issues {
+			if issue.Changelog != nil && issue.Changelog.Total > len(issue.Changelog.Histories) {
+				histories, err := getIssueChangelog(ctx, issue.Key)
+				if err != nil {
+					return nil, err
+				}
+				issue.Changelog.Histories = histories
+			}
+		}
+
+		issues = append(issues, estimatedIssuesFromBody(response.Bytes, page.Issues, estimationField, "")...)
+		startAt += len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return issues, nil
+		}
+	}
+}
+
+// changelogPageScheme is a page of /rest/api/2/issue/{key}/changelog.
+type changelogPageScheme struct {
+	StartAt int                                   `json:"startAt"`
+	Total   int                                   `json:"total"`
+	IsLast  bool                                  `json:"isLast"`
+	Values  []*models.IssueChangelogHistoryScheme `json:"values"`
+}
+
+// getIssueChangelog pages through the complete changelog of an issue.
+func getIssueChangelog(ctx context.Context, issueKey string) ([]*models.IssueChangelogHistoryScheme, error) {
+	client := services.JiraClient()
+
+	var histories []*models.IssueChangelogHistoryScheme
+	for startAt := 0; ; {
+		params := url.Values{}
+		params.Add("startAt", strconv.Itoa(startAt))
+		params.Add("maxResults", "100")
+
+		request, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/2/issue/%s/changelog?%s", issueKey, params.Encode()), "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create changelog request: %v", err)
+		}
+
+		page := new(changelogPageScheme)
+		response, err := client.Call(request, page)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get changelog: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get changelog: %v", err)
+		}
+
+		histories = append(histories, page.Values...)
+		startAt += len(page.Values)
+		if page.IsLast || len(page.Values) == 0 || startAt >= page.Total {
+			return histories, nil
+		}
+	}
+}
+
+// toJSON renders a value as indented JSON for tools that offer a JSON output.
+func toJSON(value interface{}) (string, error) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode JSON: %v", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,310 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+// componentAssigneeTypes are the default assignee types a component accepts.
+var componentAssigneeTypes = []string{"PROJECT_DEFAULT", "COMPONENT_LEAD", "PROJECT_LEAD", "UNASSIGNED"}
+
+func RegisterJiraComponentTool(s *server.MCPServer) {
+	jiraListComponentsTool := mcp.NewTool("jira_list_components",
+		mcp.WithDescription("List a project's components with their lead, default assignee and description"),
+		mcp.WithString("project_key", mcp.Required(), mcp.Description("Project identifier (e.g., KP, PROJ)")),
+	)
+	s.AddTool(jiraListComponentsTool, util.ErrorGuard(jiraListComponentsHandler))
+
+	jiraCreateComponentTool := mcp.NewTool("jira_create_component",
+		mcp.WithDescription("Create a component in a project"),
+		mcp.WithString("project_key", mcp.Required(), mcp.Description("Project identifier (e.g., KP, PROJ)")),
+		mcp.WithString("name", mcp.Required(), mcp.Description("Name of the component")),
+		mcp.WithString("description", mcp.Description("Description of the component")),
+		mcp.WithString("lead", mcp.Description("Component lead by account ID, email, name or \"me\"")),
+		mcp.WithString("assignee_type", mcp.Description("Who new issues with this component are assigned to (default: PROJECT_DEFAULT)"), mcp.Enum(componentAssigneeTypes...)),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraCreateComponentTool, util.ErrorGuard(jiraCreateComponentHandler))
+	}
+
+	jiraUpdateComponentTool := mcp.NewTool("jira_update_component",
+		mcp.WithDescription("Update a component's name, description, lead or default assignee type. Only the given fields are changed"),
+		mcp.WithString("component_id", mcp.Required(), mcp.Description("ID of the component, from jira_list_components")),
+		mcp.WithString("name", mcp.Description("New name of the component")),
+		mcp.WithString("description", mcp.Description("New description of the component")),
+		mcp.WithString("lead", mcp.Description("New component lead by account ID, email, name or \"me\", or \"none\" to remove the lead")),
+		mcp.WithString("assignee_type", mcp.Description("Who new issues with this component are assigned to"), mcp.Enum(componentAssigneeTypes...)),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraUpdateComponentTool, util.ErrorGuard(jiraUpdateComponentHandler))
+	}
+
+	jiraDeleteComponentTool := mcp.NewTool("jira_delete_component",
+		mcp.WithDescription("Delete a component, optionally moving its issues to another component"),
+		mcp.WithString("component_id", mcp.Required(), mcp.Description("ID of the component to delete")),
+		mcp.WithString("move_issues_to", mcp.Description("ID of a component that receives the deleted component's issues (default: the component is just removed from its issues)")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraDeleteComponentTool, util.ErrorGuard(jiraDeleteComponentHandler))
+	}
+
+	jiraSetIssueComponentsTool := mcp.NewTool("jira_set_issue_components",
+		mcp.WithDescription("Set, add or remove the components of a Jira issue"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+		mcp.WithString("components", mcp.Required(), mcp.Description("Comma-separated component names (e.g., Backend,API). With mode set, an empty value removes all components")),
+		mcp.WithString("mode", mcp.Description("set replaces the issue's components, add and remove keep the others (default: set)"), mcp.Enum("set", "add", "remove")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraSetIssueComponentsTool, util.ErrorGuard(jiraSetIssueComponentsHandler))
+	}
+}
+
+func jiraListComponentsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	projectKey, ok := request.Params.Arguments["project_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("project_key argument is required")
+	}
+
+	components, response, err := client.Project.Component.Gets(ctx, projectKey)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get components: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get components: %v", err)
+	}
+
+	if len(components) == 0 {
+		return mcp.NewToolResultText("No components found for this project."), nil
+	}
+
+	var sb strings.Builder
+	for _, component := range components {
+		sb.WriteString(formatComponent(component))
+		sb.WriteString("\n")
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func jiraCreateComponentHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	projectKey, ok := request.Params.Arguments["project_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("project_key argument is required")
+	}
+
+	name, ok := request.Params.Arguments["name"].(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("name argument is required")
+	}
+
+	payload := &models.ComponentPayloadScheme{Project: projectKey, Name: name, AssigneeType: "PROJECT_DEFAULT"}
+	if description, ok := request.Params.Arguments["description"].(string); ok {
+		payload.Description = description
+	}
+	if assigneeType, ok := request.Params.Arguments["assignee_type"].(string); ok && assigneeType != "" {
+		payload.AssigneeType = assigneeType
+	}
+	if lead, ok := request.Params.Arguments["lead"].(string); ok && lead != "" {
+		user, err := resolveUser(ctx, lead)
+		if err != nil {
+			return nil, err
+		}
+		payload.LeadAccountID = user.AccountID
+	}
+
+	component, response, err := client.Project.Component.Create(ctx, payload)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to create component: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to create component: %v", err)
+	}
+
+	return mcp.NewToolResultText("Component created successfully!\n\n" + formatComponent(component)), nil
+}
+
+func jiraUpdateComponentHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	componentID, ok := request.Params.Arguments["component_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("component_id argument is required")
+	}
+
+	// The SDK payload cannot send a null lead, so the update is a plain map.
+	payload := map[string]interface{}{}
+	for argument, field := range map[string]string{"name": "name", "description": "description", "assignee_type": "assigneeType"} {
+		if value, ok := request.Params.Arguments[argument].(string); ok && value != "" {
+			payload[field] = value
+		}
+	}
+	if lead, ok := request.Params.Arguments["lead"].(string); ok && lead != "" {
+		if strings.EqualFold(lead, "none") {
+			payload["leadAccountId"] = nil
+		} else {
+			user, err := resolveUser(ctx, lead)
+			if err != nil {
+				return nil, err
+			}
+			payload["leadAccountId"] = user.AccountID
+		}
+	}
+
+	if len(payload) == 0 {
+		return nil, fmt.Errorf("at least one of name, description, lead or assignee_type is required")
+	}
+
+	updateRequest, err := client.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/2/component/%s", componentID), "", payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create component update request: %v", err)
+	}
+
+	component := new(models.ComponentScheme)
+	response, err := client.Call(updateRequest, component)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to update component: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to update component: %v", err)
+	}
+
+	return mcp.NewToolResultText("Component updated successfully!\n\n" + formatComponent(component)), nil
+}
+
+func jiraDeleteComponentHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	componentID, ok := request.Params.Arguments["component_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("component_id argument is required")
+	}
+
+	endpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	if moveTo, ok := request.Params.Arguments["move_issues_to"].(string); ok && moveTo != "" {
+		if moveTo == componentID {
+			return nil, fmt.Errorf("move_issues_to must be a different component than the one being deleted")
+		}
+		params := url.Values{}
+		params.Add("moveIssuesTo", moveTo)
+		endpoint += "?" + params.Encode()
+	}
+
+	deleteRequest, err := client.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create component delete request: %v", err)
+	}
+
+	response, err := client.Call(deleteRequest, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to delete component: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to delete component: %v", err)
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Component %s deleted successfully.", componentID)), nil
+}
+
+func jiraSetIssueComponentsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	componentsArg, ok := request.Params.Arguments["components"].(string)
+	if !ok {
+		return nil, fmt.Errorf("components argument is required")
+	}
+
+	mode, _ := request.Params.Arguments["mode"].(string)
+	if mode == "" {
+		mode = "set"
+	}
+
+	names := splitList(componentsArg)
+	if len(names) == 0 && mode != "set" {
+		return nil, fmt.Errorf("components argument is required")
+	}
+
+	var mappings []map[string]interface{}
+	switch mode {
+	case "set":
+		components := make([]map[string]string, 0, len(names))
+		for _, name := range names {
+			components = append(components, map[string]string{"name": name})
+		}
+		mappings = append(mappings, map[string]interface{}{"set": components})
+	case "add", "remove":
+		for _, name := range names {
+			mappings = append(mappings, map[string]interface{}{mode: map[string]string{"name": name}})
+		}
+	default:
+		return nil, fmt.Errorf("invalid mode %q, expected set, add or remove", mode)
+	}
+
+	operations := &models.UpdateOperations{}
+	if err := operations.AddMultiRawOperation("components", mappings); err != nil {
+		return nil, fmt.Errorf("failed to build component update: %v", err)
+	}
+
+	response, err := client.Issue.Update(ctx, issueKey, true, &models.IssueSchemeV2{}, nil, operations)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to update components: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to update components: %v", err)
+	}
+
+	issue, response, err := client.Issue.Get(ctx, issueKey, []string{"components"}, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("components were updated but the issue could not be reloaded: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("components were updated but the issue could not be reloaded: %v", err)
+	}
+
+	current := "None"
+	if len(issue.Fields.Components) > 0 {
+		var currentNames []string
+		for _, component := range issue.Fields.Components {
+			currentNames = append(currentNames, component.Name)
+		}
+		current = strings.Join(currentNames, ", ")
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Components of %s updated.\nCurrent components: %s", issueKey, current)), nil
+}
+
+func formatComponent(component *models.ComponentScheme) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("ID: %s\nName: %s\n", component.ID, component.Name))
+	if component.Lead != nil {
+		sb.WriteString(fmt.Sprintf("Lead: %s\n", formatUser(component.Lead)))
+	}
+	if component.AssigneeType != "" {
+		assignee := projectAssigneeType(component.AssigneeType)
+		if component.RealAssignee != nil && component.RealAssignee.DisplayName != "" {
+			assignee += fmt.Sprintf(" (%s)", component.RealAssignee.DisplayName)
+		}
+		sb.WriteString(fmt.Sprintf("Default assignee: %s\n", assignee))
+	}
+	if component.Description != "" {
+		sb.WriteString(fmt.Sprintf("Description: %s\n", component.Description))
+	}
+	return sb.String()
+}
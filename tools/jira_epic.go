@@ -0,0 +1,198 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraEpicTool(s *server.MCPServer) {
+	jiraGetEpicTool := mcp.NewTool("jira_get_epic",
+		mcp.WithDescription("Status snapshot of an epic: its child issues (Epic Link and parent hierarchy), progress by status category and story points, open blockers, and an estimated completion date based on recent throughput"),
+		mcp.WithString("epic_key", mcp.Required(), mcp.Description("The key of the epic (e.g., KP-10)")),
+		mcp.WithString("throughput_weeks", mcp.Description("Number of recent weeks of resolved children used to estimate completion (default: 4)")),
+	)
+	s.AddTool(jiraGetEpicTool, util.ErrorGuard(jiraGetEpicHandler))
+}
+
+func jiraGetEpicHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	epicKey, ok := request.Params.Arguments["epic_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("epic_key argument is required")
+	}
+
+	weeks, err := positiveIntArgument(request, "throughput_weeks", 4)
+	if err != nil {
+		return nil, err
+	}
+
+	epic, response, err := client.Issue.Get(ctx, epicKey, []string{"summary", "status", "issuetype", "assignee"}, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get epic: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get epic: %v", err)
+	}
+
+	epicLinkField, err := findFieldID(ctx, "Epic Link")
+	if err != nil {
+		return nil, err
+	}
+
+	// Company-managed projects call the field "Story Points", team-managed ones "Story point estimate".
+	pointsField, err := findFieldID(ctx, "Story Points")
+	if err != nil {
+		return nil, err
+	}
+	if pointsField == "" {
+		if pointsField, err = findFieldID(ctx, "Story point estimate"); err != nil {
+			return nil, err
+		}
+	}
+
+	jql := fmt.Sprintf("parent = %s", quoteJQL(epic.Key))
+	if epicLinkField != "" {
+		jql = fmt.Sprintf(`"Epic Link" = %s OR %s`, quoteJQL(epic.Key), jql)
+	}
+	jql += " ORDER BY Rank ASC"
+
+	children, err := searchAllIssues(ctx, jql, boardFields(pointsField, "", "issuelinks", "resolutiondate"), nil, pointsField)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Epic: %s - %s\n", epic.Key, epic.Fields.Summary))
+	if epic.Fields.Status != nil {
+		sb.WriteString(fmt.Sprintf("Status: %s\n", epic.Fields.Status.Name))
+	}
+	if epic.Fields.Assignee != nil {
+		sb.WriteString(fmt.Sprintf("Assignee: %s\n", epic.Fields.Assignee.DisplayName))
+	}
+	if epic.Fields.IssueType != nil && epic.Fields.IssueType.HierarchyLevel < 1 && !strings.EqualFold(epic.Fields.IssueType.Name, "Epic") {
+		sb.WriteString(fmt.Sprintf("Note: %s is a %s, not an epic\n", epic.Key, epic.Fields.IssueType.Name))
+	}
+
+	if len(children) == 0 {
+		sb.WriteString("\nThis epic has no child issues.\n")
+		return mcp.NewToolResultText(sb.String()), nil
+	}
+
+	counts := map[string]int{}
+	points := map[string]float64{}
+	var totalPoints float64
+	for _, child := range children {
+		category := issueStatusCategory(child.Issue)
+		counts[category]++
+		points[category] += child.Estimate
+		totalPoints += child.Estimate
+	}
+
+	sb.WriteString(fmt.Sprintf("\nProgress (%d child issues", len(children)))
+	if pointsField != "" {
+		sb.WriteString(fmt.Sprintf(", %g points", totalPoints))
+	}
+	sb.WriteString("):\n")
+	for _, category := range statusCategoryOrder {
+		line := fmt.Sprintf("- %s: %d issues (%.0f%%)", statusCategoryNames[category], counts[category], 100*float64(counts[category])/float64(len(children)))
+		if pointsField != "" && totalPoints > 0 {
+			line += fmt.Sprintf(", %g points (%.0f%%)", points[category], 100*points[category]/totalPoints)
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	sb.WriteString("\nChild issues:\n")
+	for _, child := range children {
+		sb.WriteString(formatSprintIssue(child, pointsField) + "\n")
+	}
+
+	var blockers []string
+	for _, child := range children {
+		if issueStatusCategory(child.Issue) == "done" {
+			continue
+		}
+		for _, link := range child.Issue.Fields.IssueLinks {
+			if blocker := openBlocker(link); blocker != "" {
+				blockers = append(blockers, fmt.Sprintf("- %s is blocked by %s", child.Issue.Key, blocker))
+			}
+		}
+	}
+	if len(blockers) > 0 {
+		sb.WriteString("\nOpen blockers:\n")
+		sb.WriteString(strings.Join(blockers, "\n") + "\n")
+	} else {
+		sb.WriteString("\nOpen blockers: none\n")
+	}
+
+	sb.WriteString("\n" + epicForecast(children, weeks, pointsField, totalPoints-points["done"]) + "\n")
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// openBlocker describes the issue blocking the link's owner, or returns an
+// empty string when the link is not an unresolved "is blocked by" link.
+func openBlocker(link *models.IssueLinkScheme) string {
+	if link.Type == nil || link.InwardIssue == nil || !strings.EqualFold(link.Type.Name, "Blocks") {
+		return ""
+	}
+
+	blocker := link.InwardIssue
+	if blocker.Fields == nil {
+		return blocker.Key
+	}
+	if status := blocker.Fields.Status; status != nil {
+		if status.StatusCategory != nil && status.StatusCategory.Key == "done" {
+			return ""
+		}
+		return fmt.Sprintf("%s (%s, %s)", blocker.Key, blocker.Fields.Summary, status.Name)
+	}
+	return fmt.Sprintf("%s (%s)", blocker.Key, blocker.Fields.Summary)
+}
+
+// epicForecast projects the completion date of the remaining children from
+// the children resolved over the last weeks. Points are used when the epic
+// is estimated, issue counts otherwise.
+func epicForecast(children []*estimatedIssue, weeks int, pointsField string, remainingPoints float64) string {
+	since := time.Now().AddDate(0, 0, -7*weeks)
+
+	var resolvedIssues, remainingIssues int
+	var resolvedPoints float64
+	for _, child := range children {
+		if issueStatusCategory(child.Issue) != "done" {
+			remainingIssues++
+			continue
+		}
+		if resolved := parseJiraTime(child.Issue.Fields.Resolutiondate); !resolved.IsZero() && resolved.After(since) {
+			resolvedIssues++
+			resolvedPoints += child.Estimate
+		}
+	}
+
+	if remainingIssues == 0 {
+		return "Forecast: all child issues are done."
+	}
+
+	remaining, throughput, unit := float64(remainingIssues), float64(resolvedIssues)/float64(weeks), "issues"
+	if pointsField != "" && resolvedPoints > 0 && remainingPoints > 0 {
+		remaining, throughput, unit = remainingPoints, resolvedPoints/float64(weeks), "points"
+	}
+
+	if throughput == 0 {
+		return fmt.Sprintf("Forecast: no child issues were resolved in the last %d weeks, so completion cannot be estimated (%d issues remaining).", weeks, remainingIssues)
+	}
+
+	remainingWeeks := remaining / throughput
+	completion := time.Now().AddDate(0, 0, int(math.Ceil(remainingWeeks*7)))
+	return fmt.Sprintf("Forecast: %g %s remaining at %.1f %s/week (last %d weeks) ≈ %.1f weeks, estimated completion %s.",
+		roundTo(remaining, 1), unit, throughput, unit, weeks, remainingWeeks, completion.Format("2006-01-02"))
+}
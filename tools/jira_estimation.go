@@ -0,0 +1,168 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+)
+
+// agileIssuePage is a page of issues returned by the agile API. The SDK's
+// sprint models drop the issue fields, so pages are decoded from the raw body.
+type agileIssuePage struct {
+	StartAt    int                     `json:"startAt"`
+	MaxResults int                     `json:"maxResults"`
+	Total      int                     `json:"total"`
+	Issues     []*models.IssueSchemeV2 `json:"issues"`
+}
+
+// estimatedIssue is an issue together with the board's estimation value and
+// its Flagged state, both of which live in custom fields.
+type estimatedIssue struct {
+	Issue     *models.IssueSchemeV2
+	Estimate  float64
+	Estimated bool
+	Flagged   bool
+}
+
+// boardFields lists the issue fields needed to render and estimate agile issues.
+func boardFields(estimationField, flaggedField string, extra ...string) []string {
+	fields := append([]string{"summary", "status", "assignee", "issuetype", "priority"}, extra...)
+	if estimationField != "" {
+		fields = append(fields, estimationField)
+	}
+	if flaggedField != "" {
+		fields = append(fields, flaggedField)
+	}
+	return fields
+}
+
+// estimatedIssuesFromBody pairs decoded issues with the estimate and flag
+// custom field values found in the raw response body.
+func estimatedIssuesFromBody(body bytes.Buffer, issues []*models.IssueSchemeV2, estimationField, flaggedField string) []*estimatedIssue {
+	estimates := map[string]float64{}
+	if estimationField != "" {
+		// ParseFloatCustomFields errors when no issue is estimated, which just means no values.
+		if values, err := models.ParseFloatCustomFields(body, estimationField); err == nil {
+			estimates = values
+		}
+	}
+
+	flags := map[string][]*models.CustomFieldContextOptionScheme{}
+	if flaggedField != "" {
+		if values, err := models.ParseMultiSelectCustomFields(body, flaggedField); err == nil {
+			flags = values
+		}
+	}
+
+	result := make([]*estimatedIssue, 0, len(issues))
+	for _, issue := range issues {
+		estimate, estimated := estimates[issue.Key]
+		result = append(result, &estimatedIssue{
+			Issue:     issue,
+			Estimate:  estimate,
+			Estimated: estimated,
+			Flagged:   len(flags[issue.Key]) > 0,
+		})
+	}
+	return result
+}
+
+// getSprintIssues pages through the agile sprint issue endpoint, optionally
+// narrowed by JQL, and returns every issue with its estimate and flag.
+func getSprintIssues(ctx context.Context, sprintID int, jql, estimationField, flaggedField string) ([]*estimatedIssue, error) {
+	options := &models.IssueOptionScheme{
+		JQL:    jql,
+		Fields: boardFields(estimationField, flaggedField),
+	}
+
+	var issues []*estimatedIssue
+	for startAt := 0; ; {
+		_, response, err := services.AgileClient().Sprint.Issues(ctx, sprintID, options, startAt, 100)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get sprint issues: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get sprint issues: %v", err)
+		}
+
+		page := new(agileIssuePage)
+		if err := json.Unmarshal(response.Bytes.Bytes(), page); err != nil {
+			return nil, fmt.Errorf("failed to decode sprint issues: %v", err)
+		}
+
+		issues = append(issues, estimatedIssuesFromBody(response.Bytes, page.Issues, estimationField, flaggedField)...)
+		startAt += len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return issues, nil
+		}
+	}
+}
+
+// boardEstimationField returns the field a board estimates with, or an empty
+// string for boards that estimate by issue count.
+func boardEstimationField(config *models.BoardConfigurationScheme) string {
+	if config.Estimation == nil || config.Estimation.Field == nil {
+		return ""
+	}
+	return config.Estimation.Field.FieldID
+}
+
+// isTimeEstimate reports whether an estimation field is a time tracking
+// field, whose values are stored in seconds.
+func isTimeEstimate(estimationField string) bool {
+	switch estimationField {
+	case "timeoriginalestimate", "timeestimate", "aggregatetimeoriginalestimate", "aggregatetimeestimate":
+		return true
+	}
+	return false
+}
+
+// estimateInUnit converts a raw estimate to the board's display unit: hours
+// for time tracking fields, story points otherwise.
+func estimateInUnit(estimationField string, value float64) float64 {
+	if isTimeEstimate(estimationField) {
+		return value / 3600
+	}
+	return value
+}
+
+// estimateUnit is the unit estimateInUnit converts to.
+func estimateUnit(estimationField string) string {
+	if isTimeEstimate(estimationField) {
+		return "hours"
+	}
+	return "points"
+}
+
+// formatEstimate renders an estimate in the board's unit.
+func formatEstimate(estimationField string, value float64) string {
+	if isTimeEstimate(estimationField) {
+		return fmt.Sprintf("%.1fh", estimateInUnit(estimationField, value))
+	}
+	return fmt.Sprintf("%g pts", value)
+}
+
+// boardColumns maps each status ID to the index of the board column it is
+// shown in. doneColumn is the rightmost column with statuses mapped, which is
+// what Jira's sprint reports treat as completed work.
+func boardColumns(config *models.BoardConfigurationScheme) (names []string, columnOf map[string]int, doneColumn int) {
+	columnOf = map[string]int{}
+	doneColumn = -1
+	if config.ColumnConfig == nil {
+		return nil, columnOf, doneColumn
+	}
+	for index, column := range config.ColumnConfig.Columns {
+		names = append(names, column.Name)
+		for _, status := range column.Statuses {
+			columnOf[status.ID] = index
+		}
+		if len(column.Statuses) > 0 {
+			doneColumn = index
+		}
+	}
+	return names, columnOf, doneColumn
+}
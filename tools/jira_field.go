@@ -0,0 +1,23 @@
+package tools
+
+import (
+	"context"
+	"strings"
+)
+
+// findFieldID returns the ID of the field with the given display name (e.g.
+// "Flagged" or "Story Points"), or an empty string when no such field exists.
+func findFieldID(ctx context.Context, name string) (string, error) {
+	fields, err := getFields(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, name) {
+			return field.ID, nil
+		}
+	}
+
+	return "", nil
+}
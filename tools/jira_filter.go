@@ -0,0 +1,345 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraFilterTool(s *server.MCPServer) {
+	jiraListFiltersTool := mcp.NewTool("jira_list_filters",
+		mcp.WithDescription("Find saved Jira filters by name or owner, or list your favourite filters. Returns filter IDs and their JQL"),
+		mcp.WithString("name", mcp.Description("Only filters whose name contains this text")),
+		mcp.WithString("owner", mcp.Description("Only filters owned by this user: account ID, email, name or \"me\"")),
+		mcp.WithBoolean("favourite", mcp.Description("Only list your favourite filters (default: false)")),
+		mcp.WithString("start_at", mcp.Description("Index of the first filter to return, for pagination (default: 0)")),
+		mcp.WithString("max_results", mcp.Description("Maximum number of filters to return (default: 50)")),
+	)
+	s.AddTool(jiraListFiltersTool, util.ErrorGuard(jiraListFiltersHandler))
+
+	jiraRunFilterTool := mcp.NewTool("jira_run_filter",
+		mcp.WithDescription("Run a saved filter and return its issues, like jira_search_issue does for JQL"),
+		mcp.WithString("filter_id", mcp.Required(), mcp.Description("ID of the filter, from jira_list_filters")),
+	)
+	s.AddTool(jiraRunFilterTool, util.ErrorGuard(jiraRunFilterHandler))
+
+	jiraCreateFilterTool := mcp.NewTool("jira_create_filter",
+		mcp.WithDescription("Save a JQL query as a filter. The filter is private until shared with jira_share_filter"),
+		mcp.WithString("name", mcp.Required(), mcp.Description("Name of the filter, unique among your filters")),
+		mcp.WithString("jql", mcp.Required(), mcp.Description("JQL query of the filter")),
+		mcp.WithString("description", mcp.Description("Description of the filter")),
+		mcp.WithBoolean("favourite", mcp.Description("Add the filter to your favourites (default: true)")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraCreateFilterTool, util.ErrorGuard(jiraCreateFilterHandler))
+	}
+
+	jiraShareFilterTool := mcp.NewTool("jira_share_filter",
+		mcp.WithDescription("Share a filter with a project, project role, group, all logged-in users or everyone"),
+		mcp.WithString("filter_id", mcp.Required(), mcp.Description("ID of the filter, from jira_list_filters")),
+		mcp.WithString("type", mcp.Required(), mcp.Description("Who the filter is shared with"), mcp.Enum("project", "projectRole", "group", "authenticated", "global")),
+		mcp.WithString("project_key", mcp.Description("Project to share with, required for type project and projectRole")),
+		mcp.WithString("role_id", mcp.Description("Project role ID to share with, required for type projectRole")),
+		mcp.WithString("group", mcp.Description("Group name to share with, required for type group")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraShareFilterTool, util.ErrorGuard(jiraShareFilterHandler))
+	}
+
+	jiraUnshareFilterTool := mcp.NewTool("jira_unshare_filter",
+		mcp.WithDescription("Remove a share permission from a filter"),
+		mcp.WithString("filter_id", mcp.Required(), mcp.Description("ID of the filter")),
+		mcp.WithString("permission_id", mcp.Required(), mcp.Description("ID of the share permission, shown by jira_list_filters and jira_share_filter")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraUnshareFilterTool, util.ErrorGuard(jiraUnshareFilterHandler))
+	}
+}
+
+func jiraListFiltersHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	name, _ := request.Params.Arguments["name"].(string)
+
+	if favourite, _ := request.Params.Arguments["favourite"].(bool); favourite {
+		filters, response, err := client.Filter.Favorite(ctx)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get favourite filters: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get favourite filters: %v", err)
+		}
+
+		var sb strings.Builder
+		for _, filter := range filters {
+			if name != "" && !strings.Contains(strings.ToLower(filter.Name), strings.ToLower(name)) {
+				continue
+			}
+			sb.WriteString(formatFilter(filter.ID, filter.Name, filter.Jql, filter.Owner, filter.SharePermissions))
+			sb.WriteString("\n")
+		}
+		if sb.Len() == 0 {
+			return mcp.NewToolResultText("No favourite filters found matching the criteria."), nil
+		}
+		return mcp.NewToolResultText(sb.String()), nil
+	}
+
+	startAt, err := intArgument(request, "start_at", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults, err := positiveIntArgument(request, "max_results", 50)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &models.FilterSearchOptionScheme{
+		Name:    name,
+		OrderBy: "name",
+		Expand:  []string{"jql", "owner", "sharePermissions"},
+	}
+	if owner, ok := request.Params.Arguments["owner"].(string); ok && owner != "" {
+		user, err := resolveUser(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+		options.AccountID = user.AccountID
+	}
+
+	filters, response, err := client.Filter.Search(ctx, options, startAt, maxResults)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to search filters: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to search filters: %v", err)
+	}
+
+	if len(filters.Values) == 0 {
+		return mcp.NewToolResultText("No filters found matching the criteria."), nil
+	}
+
+	var sb strings.Builder
+	for _, filter := range filters.Values {
+		sb.WriteString(formatFilter(filter.ID, filter.Name, filter.Jql, filter.Owner, filter.SharePermissions))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("Showing %d-%d of %d filters", startAt+1, startAt+len(filters.Values), filters.Total))
+	if !filters.IsLast {
+		sb.WriteString(fmt.Sprintf(", use start_at=%d for the next page", startAt+len(filters.Values)))
+	}
+	sb.WriteString("\n")
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func jiraRunFilterHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	filterID, err := filterIDArgument(request)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, response, err := services.JiraClient().Filter.Get(ctx, filterID, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get filter: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get filter: %v", err)
+	}
+
+	result, err := renderJQLSearch(ctx, filter.Jql)
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Filter: %s (ID: %s)\nJQL: %s\n\n%s", filter.Name, filter.ID, filter.Jql, result)), nil
+}
+
+func jiraCreateFilterHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, ok := request.Params.Arguments["name"].(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("name argument is required")
+	}
+
+	jql, ok := request.Params.Arguments["jql"].(string)
+	if !ok || jql == "" {
+		return nil, fmt.Errorf("jql argument is required")
+	}
+
+	payload := &models.FilterPayloadScheme{Name: name, JQL: jql, Favorite: true}
+	if description, ok := request.Params.Arguments["description"].(string); ok {
+		payload.Description = description
+	}
+	if favourite, ok := request.Params.Arguments["favourite"].(bool); ok {
+		payload.Favorite = favourite
+	}
+
+	filter, response, err := services.JiraClient().Filter.Create(ctx, payload)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to create filter: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to create filter: %v", err)
+	}
+
+	return mcp.NewToolResultText("Filter created successfully!\n\n" + formatFilter(filter.ID, filter.Name, filter.Jql, filter.Owner, filter.SharePermissions)), nil
+}
+
+func jiraShareFilterHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	filterID, err := filterIDArgument(request)
+	if err != nil {
+		return nil, err
+	}
+
+	shareType, ok := request.Params.Arguments["type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("type argument is required")
+	}
+
+	payload := &models.PermissionFilterPayloadScheme{Type: shareType}
+	switch shareType {
+	case "project", "projectRole":
+		projectKey, _ := request.Params.Arguments["project_key"].(string)
+		if projectKey == "" {
+			return nil, fmt.Errorf("project_key argument is required for type %s", shareType)
+		}
+		project, response, err := client.Project.Get(ctx, projectKey, nil)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get project: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get project: %v", err)
+		}
+		payload.ProjectID = project.ID
+
+		if shareType == "projectRole" {
+			payload.ProjectRoleID, _ = request.Params.Arguments["role_id"].(string)
+			if payload.ProjectRoleID == "" {
+				return nil, fmt.Errorf("role_id argument is required for type projectRole")
+			}
+		}
+	case "group":
+		payload.GroupName, _ = request.Params.Arguments["group"].(string)
+		if payload.GroupName == "" {
+			return nil, fmt.Errorf("group argument is required for type group")
+		}
+	case "authenticated", "global":
+	default:
+		return nil, fmt.Errorf("invalid type %q, expected project, projectRole, group, authenticated or global", shareType)
+	}
+
+	permissions, response, err := client.Filter.Share.Add(ctx, filterID, payload)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to share filter: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to share filter: %v", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Filter %d shared successfully.\nShared with:\n", filterID))
+	for _, permission := range permissions {
+		sb.WriteString(fmt.Sprintf("- %s (permission ID: %d)\n", formatSharePermission(permission), permission.ID))
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func jiraUnshareFilterHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	filterID, err := filterIDArgument(request)
+	if err != nil {
+		return nil, err
+	}
+
+	permissionIDStr, ok := request.Params.Arguments["permission_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("permission_id argument is required")
+	}
+
+	permissionID, err := strconv.Atoi(permissionIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permission_id: %v", err)
+	}
+
+	response, err := services.JiraClient().Filter.Share.Delete(ctx, filterID, permissionID)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to remove share permission: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to remove share permission: %v", err)
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Share permission %d removed from filter %d.", permissionID, filterID)), nil
+}
+
+func filterIDArgument(request mcp.CallToolRequest) (int, error) {
+	filterIDStr, ok := request.Params.Arguments["filter_id"].(string)
+	if !ok {
+		return 0, fmt.Errorf("filter_id argument is required")
+	}
+
+	filterID, err := strconv.Atoi(filterIDStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid filter_id: %v", err)
+	}
+	return filterID, nil
+}
+
+// formatFilter renders a filter. The SDK has separate filter models for
+// search results and single filters, so it takes the shared fields.
+func formatFilter(id, name, jql string, owner *models.UserScheme, permissions []*models.SharePermissionScheme) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("ID: %s\nName: %s\n", id, name))
+	if owner != nil {
+		sb.WriteString(fmt.Sprintf("Owner: %s\n", owner.DisplayName))
+	}
+	if jql != "" {
+		sb.WriteString(fmt.Sprintf("JQL: %s\n", jql))
+	}
+	if len(permissions) == 0 {
+		sb.WriteString("Shared with: private\n")
+	} else {
+		var shares []string
+		for _, permission := range permissions {
+			shares = append(shares, fmt.Sprintf("%s (permission ID: %d)", formatSharePermission(permission), permission.ID))
+		}
+		sb.WriteString(fmt.Sprintf("Shared with: %s\n", strings.Join(shares, ", ")))
+	}
+	return sb.String()
+}
+
+func formatSharePermission(permission *models.SharePermissionScheme) string {
+	switch permission.Type {
+	case "project":
+		if permission.Project != nil {
+			return "project " + permission.Project.Key
+		}
+	case "projectRole":
+		if permission.Project != nil && permission.Role != nil {
+			return fmt.Sprintf("role %s in project %s", permission.Role.Name, permission.Project.Key)
+		}
+	case "group":
+		if permission.Group != nil {
+			return "group " + permission.Group.Name
+		}
+	case "user":
+		if permission.User != nil {
+			return "user " + permission.User.DisplayName
+		}
+	case "authenticated", "loggedin":
+		return "all logged-in users"
+	case "global":
+		return "everyone"
+	}
+	return permission.Type
+}
@@ -0,0 +1,204 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraFindTool(s *server.MCPServer) {
+	jiraFindIssuesTool := mcp.NewTool("jira_find_issues",
+		mcp.WithDescription("Find Jira issues without writing JQL. The criteria are combined with AND into a JQL query, which is returned with the results so it can be refined and reused in jira_search_issue"),
+		mcp.WithString("project", mcp.Description("Comma-separated project keys (e.g., KP, PROJ)")),
+		mcp.WithString("status", mcp.Description("Comma-separated status names (e.g., To Do, In Review)")),
+		mcp.WithString("status_category", mcp.Description("Status category of the issues"), mcp.Enum("To Do", "In Progress", "Done")),
+		mcp.WithString("assignee", mcp.Description("Comma-separated assignees: account ID, email, name, \"me\" or \"unassigned\"")),
+		mcp.WithString("labels", mcp.Description("Comma-separated labels, issues with any of them match")),
+		mcp.WithString("text", mcp.Description("Text to look for in the summary, description and comments. Searched as plain text, characters like + - [ ] are not operators")),
+		mcp.WithString("created_from", mcp.Description("Created on or after this date, YYYY-MM-DD")),
+		mcp.WithString("created_to", mcp.Description("Created on or before this date, YYYY-MM-DD")),
+		mcp.WithString("updated_from", mcp.Description("Updated on or after this date, YYYY-MM-DD")),
+		mcp.WithString("updated_to", mcp.Description("Updated on or before this date, YYYY-MM-DD")),
+		mcp.WithString("sprint", mcp.Description("\"current\" for issues in an open sprint, or a sprint name or ID")),
+		mcp.WithString("order_by", mcp.Description("Comma-separated fields with an optional ASC or DESC (default: updated DESC)")),
+	)
+	s.AddTool(jiraFindIssuesTool, util.ErrorGuard(jiraFindIssuesHandler))
+}
+
+// findDateLayout is the date format of the created and updated ranges.
+const findDateLayout = "2006-01-02"
+
+// jqlFieldPattern matches field names that can be used in JQL unquoted.
+var jqlFieldPattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_.]*|cf\[\d+\])$`)
+
+func jiraFindIssuesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	argument := func(name string) string {
+		value, _ := request.Params.Arguments[name].(string)
+		return strings.TrimSpace(value)
+	}
+
+	var clauses []string
+
+	if projects := splitList(argument("project")); len(projects) > 0 {
+		clauses = append(clauses, "project IN "+jqlList(projects))
+	}
+
+	if statuses := splitList(argument("status")); len(statuses) > 0 {
+		clauses = append(clauses, "status IN "+jqlList(statuses))
+	}
+
+	if category := argument("status_category"); category != "" {
+		clauses = append(clauses, "statusCategory = "+quoteJQL(category))
+	}
+
+	if assignees := splitList(argument("assignee")); len(assignees) > 0 {
+		clause, err := assigneeJQL(ctx, assignees)
+		if err != nil {
+			return nil, err
+		}
+		clauses = append(clauses, clause)
+	}
+
+	if labels := splitList(argument("labels")); len(labels) > 0 {
+		clauses = append(clauses, "labels IN "+jqlList(labels))
+	}
+
+	if text := argument("text"); text != "" {
+		clauses = append(clauses, "text ~ "+quoteJQLText(text))
+	}
+
+	for _, field := range []string{"created", "updated"} {
+		if from := argument(field + "_from"); from != "" {
+			date, err := time.Parse(findDateLayout, from)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s_from date, expected YYYY-MM-DD: %v", field, err)
+			}
+			clauses = append(clauses, fmt.Sprintf("%s >= %s", field, quoteJQL(date.Format(findDateLayout))))
+		}
+		if to := argument(field + "_to"); to != "" {
+			date, err := time.Parse(findDateLayout, to)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s_to date, expected YYYY-MM-DD: %v", field, err)
+			}
+			// A bare date means midnight, so the end of the range is the next day.
+			clauses = append(clauses, fmt.Sprintf("%s < %s", field, quoteJQL(date.AddDate(0, 0, 1).Format(findDateLayout))))
+		}
+	}
+
+	if sprint := argument("sprint"); sprint != "" {
+		if strings.EqualFold(sprint, "current") {
+			clauses = append(clauses, "sprint IN openSprints()")
+		} else if _, err := strconv.Atoi(sprint); err == nil {
+			clauses = append(clauses, "sprint = "+sprint)
+		} else {
+			clauses = append(clauses, "sprint = "+quoteJQL(sprint))
+		}
+	}
+
+	orderBy := orderByJQL(argument("order_by"))
+
+	jql := strings.Join(clauses, " AND ")
+	if jql == "" {
+		jql = orderBy
+	} else {
+		jql += " " + orderBy
+	}
+
+	result, err := renderJQLSearch(ctx, jql)
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("JQL: %s\n\n%s", jql, result)), nil
+}
+
+// jqlList renders values as a quoted JQL list for the IN operator.
+func jqlList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = quoteJQL(value)
+	}
+	return "(" + strings.Join(quoted, ", ") + ")"
+}
+
+// jqlTextEscaper escapes the characters Jira's text search treats as
+// operators, including the backslash used to escape them.
+var jqlTextEscaper = strings.NewReplacer(
+	`\`, `\\`, `+`, `\+`, `-`, `\-`, `&`, `\&`, `|`, `\|`, `!`, `\!`, `(`, `\(`, `)`, `\)`,
+	`{`, `\{`, `}`, `\}`, `[`, `\[`, `]`, `\]`, `^`, `\^`, `~`, `\~`, `*`, `\*`, `?`, `\?`,
+	`:`, `\:`, `/`, `\/`,
+)
+
+// quoteJQLText quotes a value for the ~ operator so it is searched for as
+// plain text. quoteJQL then doubles each escaping backslash, as JQL string
+// literals require.
+func quoteJQLText(value string) string {
+	return quoteJQL(jqlTextEscaper.Replace(value))
+}
+
+// assigneeJQL matches issues assigned to any of the users. "me" becomes
+// currentUser() so the query stays reusable by others, and "unassigned"
+// matches issues without an assignee.
+func assigneeJQL(ctx context.Context, assignees []string) (string, error) {
+	var users []string
+	unassigned := false
+	for _, assignee := range assignees {
+		switch {
+		case strings.EqualFold(assignee, "unassigned"):
+			unassigned = true
+		case strings.EqualFold(assignee, "me"):
+			users = append(users, "currentUser()")
+		default:
+			user, err := resolveUser(ctx, assignee)
+			if err != nil {
+				return "", err
+			}
+			users = append(users, quoteJQL(user.AccountID))
+		}
+	}
+
+	var clauses []string
+	if len(users) > 0 {
+		clauses = append(clauses, fmt.Sprintf("assignee IN (%s)", strings.Join(users, ", ")))
+	}
+	if unassigned {
+		clauses = append(clauses, "assignee IS EMPTY")
+	}
+	if len(clauses) == 1 {
+		return clauses[0], nil
+	}
+	return "(" + strings.Join(clauses, " OR ") + ")", nil
+}
+
+// orderByJQL builds the ORDER BY clause from "field [ASC|DESC]" items,
+// quoting field names that are not plain identifiers.
+func orderByJQL(value string) string {
+	items := splitList(value)
+	if len(items) == 0 {
+		items = []string{"updated DESC"}
+	}
+
+	var orders []string
+	for _, item := range items {
+		field, direction := item, ""
+		if index := strings.LastIndex(item, " "); index >= 0 {
+			switch suffix := strings.ToUpper(item[index+1:]); suffix {
+			case "ASC", "DESC":
+				field, direction = strings.TrimSpace(item[:index]), " "+suffix
+			}
+		}
+		if !jqlFieldPattern.MatchString(field) {
+			field = quoteJQL(field)
+		}
+		orders = append(orders, field+direction)
+	}
+
+	return "ORDER BY " + strings.Join(orders, ", ")
+}
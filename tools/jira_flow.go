@@ -0,0 +1,332 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+// flowDateLayout is the date format of the flow metrics date range.
+const flowDateLayout = "2006-01-02"
+
+func RegisterJiraFlowTool(s *server.MCPServer) {
+	jiraFlowMetricsTool := mcp.NewTool("jira_flow_metrics",
+		mcp.WithDescription("Kanban flow metrics for a JQL scope and date range: cycle time (first In Progress to Done) and lead time (created to Done) per issue with 50/85/95th percentiles, weekly throughput, and the age of work in progress per column"),
+		mcp.WithString("jql", mcp.Required(), mcp.Description("JQL selecting the issues to measure (e.g., 'project = KP AND issuetype != Epic')")),
+		mcp.WithString("from", mcp.Description("Start of the range, YYYY-MM-DD (default: 8 weeks before to)")),
+		mcp.WithString("to", mcp.Description("End of the range, inclusive, YYYY-MM-DD (default: today)")),
+		mcp.WithString("board_id", mcp.Description("Optional board whose columns are used to group work in progress (default: group by status)")),
+		mcp.WithBoolean("include_json", mcp.Description("Also return the metrics as JSON (default: false)")),
+	)
+	s.AddTool(jiraFlowMetricsTool, util.ErrorGuard(jiraFlowMetricsHandler))
+}
+
+// flowIssue holds the flow timestamps of one issue. Started is zero for issues
+// that never passed through an In Progress status.
+type flowIssue struct {
+	Key       string    `json:"key"`
+	Summary   string    `json:"summary"`
+	Status    string    `json:"status"`
+	Created   time.Time `json:"created"`
+	Started   time.Time `json:"-"`
+	Done      time.Time `json:"-"`
+	LeadDays  float64   `json:"lead_days,omitempty"`
+	CycleDays float64   `json:"cycle_days,omitempty"`
+	AgeDays   float64   `json:"age_days,omitempty"`
+}
+
+// MarshalJSON leaves out Started and Done when they are unset, which
+// omitempty cannot do for time.Time values.
+func (f flowIssue) MarshalJSON() ([]byte, error) {
+	type plainFlowIssue flowIssue
+	out := struct {
+		plainFlowIssue
+		Started *time.Time `json:"started,omitempty"`
+		Done    *time.Time `json:"done,omitempty"`
+	}{plainFlowIssue: plainFlowIssue(f)}
+	if !f.Started.IsZero() {
+		out.Started = &f.Started
+	}
+	if !f.Done.IsZero() {
+		out.Done = &f.Done
+	}
+	return json.Marshal(out)
+}
+
+type flowPercentiles struct {
+	Count int     `json:"count"`
+	P50   float64 `json:"p50"`
+	P85   float64 `json:"p85"`
+	P95   float64 `json:"p95"`
+}
+
+type flowWeek struct {
+	WeekOf    string `json:"week_of"`
+	Completed int    `json:"completed"`
+}
+
+type flowWIPGroup struct {
+	Name   string       `json:"name"`
+	Issues []*flowIssue `json:"issues"`
+}
+
+type flowMetrics struct {
+	JQL        string          `json:"jql"`
+	From       string          `json:"from"`
+	To         string          `json:"to"`
+	CycleTime  flowPercentiles `json:"cycle_time_days"`
+	LeadTime   flowPercentiles `json:"lead_time_days"`
+	Throughput []*flowWeek     `json:"weekly_throughput"`
+	Completed  []*flowIssue    `json:"completed"`
+	WIP        []*flowWIPGroup `json:"wip"`
+}
+
+func jiraFlowMetricsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	jql, ok := request.Params.Arguments["jql"].(string)
+	if !ok {
+		return nil, fmt.Errorf("jql argument is required")
+	}
+
+	now := time.Now()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	if toStr, ok := request.Params.Arguments["to"].(string); ok && toStr != "" {
+		parsed, err := time.ParseInLocation(flowDateLayout, toStr, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date, expected YYYY-MM-DD: %v", err)
+		}
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, -7*8)
+	if fromStr, ok := request.Params.Arguments["from"].(string); ok && fromStr != "" {
+		parsed, err := time.ParseInLocation(flowDateLayout, fromStr, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date, expected YYYY-MM-DD: %v", err)
+		}
+		from = parsed
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("from date must not be after to date")
+	}
+	end := to.AddDate(0, 0, 1)
+
+	includeJSON, _ := request.Params.Arguments["include_json"].(bool)
+
+	statuses, err := newStatusNormalizer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The issue was last updated no earlier than it was done, so this bounds
+	// the search without relying on Cloud-only JQL functions.
+	fields := []string{"summary", "status", "created"}
+	completedJQL := andJQL(jql, fmt.Sprintf("statusCategory = Done AND updated >= %s", quoteJQL(from.Format(flowDateLayout))))
+	completedIssues, err := searchIssuesWithChangelog(ctx, completedJQL, fields, "")
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := &flowMetrics{JQL: jql, From: from.Format(flowDateLayout), To: to.Format(flowDateLayout)}
+
+	var cycleTimes, leadTimes []float64
+	for _, issue := range completedIssues {
+		flow := newFlowIssue(issue, statuses)
+		if flow.Done.Before(from) || !flow.Done.Before(end) {
+			continue
+		}
+
+		flow.LeadDays = roundTo(flow.Done.Sub(flow.Created).Hours()/24, 1)
+		leadTimes = append(leadTimes, flow.LeadDays)
+		if !flow.Started.IsZero() {
+			flow.CycleDays = roundTo(flow.Done.Sub(flow.Started).Hours()/24, 1)
+			cycleTimes = append(cycleTimes, flow.CycleDays)
+		}
+		metrics.Completed = append(metrics.Completed, flow)
+	}
+	sort.Slice(metrics.Completed, func(i, j int) bool { return metrics.Completed[i].Done.Before(metrics.Completed[j].Done) })
+
+	metrics.CycleTime = percentiles(cycleTimes)
+	metrics.LeadTime = percentiles(leadTimes)
+
+	for weekStart := from; weekStart.Before(end); weekStart = weekStart.AddDate(0, 0, 7) {
+		week := &flowWeek{WeekOf: weekStart.Format(flowDateLayout)}
+		weekEnd := weekStart.AddDate(0, 0, 7)
+		for _, flow := range metrics.Completed {
+			if !flow.Done.Before(weekStart) && flow.Done.Before(weekEnd) {
+				week.Completed++
+			}
+		}
+		metrics.Throughput = append(metrics.Throughput, week)
+	}
+
+	wipIssues, err := searchIssuesWithChangelog(ctx, andJQL(jql, `statusCategory = "In Progress"`), fields, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Work in progress is grouped by board column when a board is given, in
+	// board order, and by status name otherwise.
+	var groupNames []string
+	groupOf := statuses.name
+	if boardIDStr, ok := request.Params.Arguments["board_id"].(string); ok && boardIDStr != "" {
+		boardID, err := strconv.Atoi(boardIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid board_id: %v", err)
+		}
+		config, err := getBoardConfiguration(ctx, boardID)
+		if err != nil {
+			return nil, err
+		}
+		names, columnOf, _ := boardColumns(config)
+		groupNames = names
+		groupOf = func(statusID string) string {
+			if column, ok := columnOf[statusID]; ok {
+				return names[column]
+			}
+			return "Unmapped"
+		}
+	}
+
+	groups := map[string]*flowWIPGroup{}
+	for _, issue := range wipIssues {
+		flow := newFlowIssue(issue, statuses)
+		since := flow.Started
+		if since.IsZero() {
+			since = flow.Created
+		}
+		flow.AgeDays = roundTo(now.Sub(since).Hours()/24, 1)
+
+		var statusID string
+		if issue.Issue.Fields.Status != nil {
+			statusID = issue.Issue.Fields.Status.ID
+		}
+		name := groupOf(statusID)
+		if groups[name] == nil {
+			groups[name] = &flowWIPGroup{Name: name}
+			if !slices.Contains(groupNames, name) {
+				groupNames = append(groupNames, name)
+			}
+		}
+		groups[name].Issues = append(groups[name].Issues, flow)
+	}
+	for _, name := range groupNames {
+		if group := groups[name]; group != nil {
+			sort.Slice(group.Issues, func(i, j int) bool { return group.Issues[i].AgeDays > group.Issues[j].AgeDays })
+			metrics.WIP = append(metrics.WIP, group)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Flow metrics for: %s\nPeriod: %s to %s\nCompleted: %d issues\n\n", jql, metrics.From, metrics.To, len(metrics.Completed)))
+	sb.WriteString(formatPercentiles("Cycle time", metrics.CycleTime))
+	sb.WriteString(formatPercentiles("Lead time", metrics.LeadTime))
+
+	sb.WriteString("\nWeekly throughput:\n| Week of | Completed |\n|---|---|\n")
+	for _, week := range metrics.Throughput {
+		sb.WriteString(fmt.Sprintf("| %s | %d |\n", week.WeekOf, week.Completed))
+	}
+
+	if len(metrics.Completed) > 0 {
+		sb.WriteString("\nCompleted issues:\n| Key | Summary | Done | Lead (days) | Cycle (days) |\n|---|---|---|---|---|\n")
+		for _, flow := range metrics.Completed {
+			cycle := "-"
+			if !flow.Started.IsZero() {
+				cycle = fmt.Sprintf("%g", flow.CycleDays)
+			}
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %g | %s |\n", flow.Key, flow.Summary, flow.Done.Format(flowDateLayout), flow.LeadDays, cycle))
+		}
+	}
+
+	sb.WriteString("\nWork in progress aging:\n")
+	if len(metrics.WIP) == 0 {
+		sb.WriteString("No issues in progress.\n")
+	}
+	for _, group := range metrics.WIP {
+		var total float64
+		for _, flow := range group.Issues {
+			total += flow.AgeDays
+		}
+		sb.WriteString(fmt.Sprintf("\n%s (%d issues, average age %.1f days):\n", group.Name, len(group.Issues), total/float64(len(group.Issues))))
+		for _, flow := range group.Issues {
+			line := fmt.Sprintf("- %s: %s | %g days", flow.Key, flow.Summary, flow.AgeDays)
+			if metrics.CycleTime.Count > 0 && flow.AgeDays > metrics.CycleTime.P85 {
+				line += " (older than the 85th percentile cycle time)"
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+
+	if includeJSON {
+		data, err := toJSON(metrics)
+		if err != nil {
+			return nil, err
+		}
+		sb.WriteString("\n```json\n" + data + "\n```\n")
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// newFlowIssue replays an issue's status changes: work starts at the first
+// move into an In Progress category status and is done at the last move into
+// a Done category status.
+func newFlowIssue(issue *estimatedIssue, statuses *statusNormalizer) *flowIssue {
+	fields := issue.Issue.Fields
+	flow := &flowIssue{Key: issue.Issue.Key, Summary: fields.Summary, Created: parseJiraTime(fields.Created)}
+	if fields.Status != nil {
+		flow.Status = fields.Status.Name
+	}
+
+	for _, change := range changelogHistory(issue.Issue, "status", "status") {
+		toCategory := statuses.category(change.To)
+		if toCategory == "indeterminate" && flow.Started.IsZero() {
+			flow.Started = change.At
+		}
+		if toCategory == "done" && statuses.category(change.From) != "done" {
+			flow.Done = change.At
+		}
+	}
+
+	// Issues created directly in a Done status have no transition to replay.
+	if flow.Done.IsZero() && fields.Status != nil && statuses.category(fields.Status.ID) == "done" {
+		flow.Done = flow.Created
+	}
+	if !flow.Done.IsZero() && flow.Started.After(flow.Done) {
+		flow.Started = time.Time{}
+	}
+	return flow
+}
+
+// percentiles summarizes durations with the nearest-rank method.
+func percentiles(values []float64) flowPercentiles {
+	result := flowPercentiles{Count: len(values)}
+	if len(values) == 0 {
+		return result
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	rank := func(p float64) float64 {
+		index := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+		return sorted[max(index, 0)]
+	}
+	result.P50, result.P85, result.P95 = rank(50), rank(85), rank(95)
+	return result
+}
+
+func formatPercentiles(name string, p flowPercentiles) string {
+	if p.Count == 0 {
+		return fmt.Sprintf("%s: no data\n", name)
+	}
+	return fmt.Sprintf("%s (days, %d issues): 50%% ≤ %g, 85%% ≤ %g, 95%% ≤ %g\n", name, p.Count, p.P50, p.P85, p.P95)
+}
@@ -2,7 +2,12 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -39,6 +44,26 @@ func RegisterJiraIssueTool(s *server.MCPServer) {
 		s.AddTool(jiraUpdateIssueTool, util.ErrorGuard(jiraUpdateIssueHandler))
 	}
 
+	jiraDeleteIssueTool := mcp.NewTool("jira_delete_issue",
+		mcp.WithDescription("Permanently delete a Jira issue. This cannot be undone, so the issue key must be repeated in the confirm argument"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the issue to delete (e.g., KP-2)")),
+		mcp.WithString("confirm", mcp.Required(), mcp.Description("Must be exactly the same as issue_key to confirm the deletion")),
+		mcp.WithBoolean("delete_subtasks", mcp.Description("Also delete the issue's subtasks. Required when the issue has subtasks (default: false)")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraDeleteIssueTool, util.ErrorGuard(jiraDeleteIssueHandler))
+	}
+
+	jiraMoveIssueTool := mcp.NewTool("jira_move_issue",
+		mcp.WithDescription("Move an issue to another project and/or issue type. Statuses and fields are mapped to the target defaults unless overridden. Returns the issue's new key"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the issue to move (e.g., KP-2)")),
+		mcp.WithString("target_project_key", mcp.Required(), mcp.Description("Project the issue will be moved to (e.g., PROJ)")),
+		mcp.WithString("target_issue_type", mcp.Required(), mcp.Description("Issue type name in the target project (e.g., Task, Bug)")),
+		mcp.WithString("field_mapping", mcp.Description("Optional JSON object of values for fields required by the target project, keyed by field ID (e.g., {\"customfield_10010\": \"value\", \"components\": [\"10001\"]})")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraMoveIssueTool, util.ErrorGuard(jiraMoveIssueHandler))
+	}
 }
 
 func jiraIssueHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -65,6 +90,29 @@ func jiraIssueHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 		}
 	}
 
+	// Remote links are secondary, so a failure to load them, e.g. without
+	// permission, is reported inline instead of failing the whole issue.
+	var links string
+	remoteLinks, response, err := client.Issue.Link.Remote.Gets(ctx, issueKey, "")
+	if err != nil {
+		reason := err.Error()
+		if response != nil {
+			reason = fmt.Sprintf("HTTP %d", response.Code)
+		}
+		links = fmt.Sprintf("\nRemote Links: unavailable (%s)\n", reason)
+	} else {
+		var lines []string
+		for _, link := range remoteLinks {
+			if link.Object == nil {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("- %s: %s (ID: %d)\n", link.Object.Title, link.Object.URL, link.ID))
+		}
+		if len(lines) > 0 {
+			links = "\nRemote Links:\n" + strings.Join(lines, "")
+		}
+	}
+
 	var transitions string
 	for _, transition := range issue.Transitions {
 		transitions += fmt.Sprintf("- %s (ID: %s)\n", transition.Name, transition.ID)
@@ -85,6 +133,11 @@ func jiraIssueHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 		priorityName = issue.Fields.Priority.Name
 	}
 
+	watchCount := 0
+	if issue.Fields.Watcher != nil {
+		watchCount = issue.Fields.Watcher.WatchCount
+	}
+
 	result := fmt.Sprintf(`
 Key: %s
 Summary: %s
@@ -94,9 +147,10 @@ Assignee: %s
 Created: %s
 Updated: %s
 Priority: %s
+Watchers: %d
 Description:
 %s
-%s
+%s%s
 Available Transitions:
 %s`,
 		issue.Key,
@@ -107,8 +161,10 @@ Available Transitions:
 		issue.Fields.Created,
 		issue.Fields.Updated,
 		priorityName,
+		watchCount,
 		issue.Fields.Description,
 		subtasks,
+		links,
 		transitions,
 	)
 
@@ -189,3 +245,234 @@ func jiraUpdateIssueHandler(ctx context.Context, request mcp.CallToolRequest) (*
 
 	return mcp.NewToolResultText("Issue updated successfully!"), nil
 }
+
+func jiraDeleteIssueHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok || issueKey == "" {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	confirm, ok := request.Params.Arguments["confirm"].(string)
+	if !ok || confirm != issueKey {
+		return nil, fmt.Errorf("deletion not confirmed: confirm must be exactly %q", issueKey)
+	}
+
+	deleteSubtasks, _ := request.Params.Arguments["delete_subtasks"].(bool)
+
+	response, err := client.Issue.Delete(ctx, issueKey, deleteSubtasks)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to delete issue: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to delete issue: %v", err)
+	}
+
+	result := fmt.Sprintf("Issue %s deleted successfully!", issueKey)
+	if deleteSubtasks {
+		result += " Its subtasks were deleted as well."
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+// bulkMoveTaskScheme is the progress of an asynchronous bulk move, as returned
+// by /rest/api/2/bulk/queue/{taskId}.
+type bulkMoveTaskScheme struct {
+	TaskID                    string              `json:"taskId"`
+	Status                    string              `json:"status"`
+	ProgressPercent           int                 `json:"progressPercent"`
+	ProcessedAccessibleIssues []int               `json:"processedAccessibleIssues"`
+	FailedAccessibleIssues    map[string][]string `json:"failedAccessibleIssues"`
+}
+
+func jiraMoveIssueHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok || issueKey == "" {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	targetProjectKey, ok := request.Params.Arguments["target_project_key"].(string)
+	if !ok || targetProjectKey == "" {
+		return nil, fmt.Errorf("target_project_key argument is required")
+	}
+
+	targetIssueType, ok := request.Params.Arguments["target_issue_type"].(string)
+	if !ok || targetIssueType == "" {
+		return nil, fmt.Errorf("target_issue_type argument is required")
+	}
+
+	mandatoryFields := map[string]interface{}{}
+	if fieldMapping, ok := request.Params.Arguments["field_mapping"].(string); ok && fieldMapping != "" {
+		var values map[string]interface{}
+		if err := json.Unmarshal([]byte(fieldMapping), &values); err != nil {
+			return nil, fmt.Errorf("invalid field_mapping, expected a JSON object: %v", err)
+		}
+		for fieldID, value := range values {
+			mandatoryFields[fieldID] = map[string]interface{}{
+				"retain": false,
+				"type":   "raw",
+				"value":  fieldMappingValues(value),
+			}
+		}
+	}
+
+	issue, response, err := client.Issue.Get(ctx, issueKey, []string{"project", "issuetype"}, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get issue: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get issue: %v", err)
+	}
+
+	project, response, err := client.Project.Get(ctx, targetProjectKey, []string{"issueTypes"})
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get target project: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get target project: %v", err)
+	}
+
+	var issueTypeID string
+	var availableTypes []string
+	for _, issueType := range project.IssueTypes {
+		availableTypes = append(availableTypes, issueType.Name)
+		if strings.EqualFold(issueType.Name, targetIssueType) {
+			issueTypeID = issueType.ID
+		}
+	}
+	if issueTypeID == "" {
+		return nil, fmt.Errorf("issue type %q not found in project %s (available: %s)", targetIssueType, project.Key, strings.Join(availableTypes, ", "))
+	}
+
+	sourceMapping := map[string]interface{}{
+		"issueIdsOrKeys":              []string{issue.ID},
+		"inferClassificationDefaults": true,
+		"inferFieldDefaults":          true,
+		"inferStatusDefaults":         true,
+		"inferSubtaskTypeDefault":     true,
+	}
+	if len(mandatoryFields) > 0 {
+		sourceMapping["targetMandatoryFields"] = []map[string]interface{}{{"fields": mandatoryFields}}
+	}
+
+	payload := map[string]interface{}{
+		"sendBulkNotification": true,
+		"targetToSourcesMapping": map[string]interface{}{
+			fmt.Sprintf("%s,%s", project.Key, issueTypeID): sourceMapping,
+		},
+	}
+
+	moveRequest, err := client.NewRequest(ctx, http.MethodPost, "rest/api/2/bulk/issues/move", "", payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create move request: %v", err)
+	}
+
+	var submitted struct {
+		TaskID string `json:"taskId"`
+	}
+	response, err = client.Call(moveRequest, &submitted)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to move issue: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to move issue: %v", err)
+	}
+
+	task, err := waitForBulkMove(ctx, submitted.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if errs, ok := task.FailedAccessibleIssues[issue.ID]; ok && len(errs) > 0 {
+		return nil, fmt.Errorf("failed to move issue %s: %s", issueKey, strings.Join(errs, "; "))
+	}
+	if task.Status != "COMPLETE" {
+		return nil, fmt.Errorf("move task %s ended with status %s", task.TaskID, task.Status)
+	}
+
+	// The issue keeps its ID across projects, so fetching it by ID yields the new key.
+	moved, response, err := client.Issue.Get(ctx, issue.ID, []string{"project", "issuetype"}, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("issue was moved but could not be reloaded: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("issue was moved but could not be reloaded: %v", err)
+	}
+
+	result := fmt.Sprintf("Issue moved successfully!\nOld Key: %s\nNew Key: %s\nProject: %s\nIssue Type: %s\nURL: %s",
+		issueKey,
+		moved.Key,
+		moved.Fields.Project.Key,
+		moved.Fields.IssueType.Name,
+		moved.Self,
+	)
+
+	return mcp.NewToolResultText(result), nil
+}
+
+// waitForBulkMove polls the bulk operation queue until the task leaves the
+// ENQUEUED/RUNNING states or the timeout elapses.
+func waitForBulkMove(ctx context.Context, taskID string) (*bulkMoveTaskScheme, error) {
+	client := services.JiraClient()
+
+	deadline := time.Now().Add(2 * time.Minute)
+	for {
+		request, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/2/bulk/queue/%s", taskID), "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create task request: %v", err)
+		}
+
+		task := new(bulkMoveTaskScheme)
+		response, err := client.Call(request, task)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get move task progress: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get move task progress: %v", err)
+		}
+
+		if task.Status != "ENQUEUED" && task.Status != "RUNNING" {
+			return task, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("move task %s is still %s (%d%%), check the issue later", taskID, task.Status, task.ProgressPercent)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// fieldMappingValues converts a field_mapping value into the list of raw
+// string values expected by the bulk move API.
+func fieldMappingValues(value interface{}) []string {
+	switch v := value.(type) {
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, fieldMappingValue(item))
+		}
+		return values
+	case nil:
+		return []string{}
+	default:
+		return []string{fieldMappingValue(v)}
+	}
+}
+
+// fieldMappingValue formats one mapping value. JSON numbers decode as
+// float64, which fmt.Sprint prints in e-notation for large IDs.
+func fieldMappingValue(value interface{}) string {
+	if number, ok := value.(float64); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,299 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraJQLTool(s *server.MCPServer) {
+	jiraValidateJQLTool := mcp.NewTool("jira_validate_jql",
+		mcp.WithDescription("Check a JQL query before running it. Returns the parse errors and warnings with their line and character position, and the ORDER BY fields of a valid query"),
+		mcp.WithString("jql", mcp.Required(), mcp.Description("JQL query to validate (e.g., 'project = KP AND status = \"In Progress\"')")),
+		mcp.WithString("validation", mcp.Description("strict reports unknown fields and values as errors, warn reports them as warnings, none only checks the syntax (default: strict)"), mcp.Enum("strict", "warn", "none")),
+	)
+	s.AddTool(jiraValidateJQLTool, util.ErrorGuard(jiraValidateJQLHandler))
+
+	jiraJQLSuggestTool := mcp.NewTool("jira_jql_suggest",
+		mcp.WithDescription("Autocomplete JQL. Without field_name, lists the fields usable in JQL with their operators, plus the JQL functions. With field_name, lists the field's operators and suggests values for it"),
+		mcp.WithString("field_name", mcp.Description("JQL name of the field to suggest values for (e.g., project, assignee, cf[10020])")),
+		mcp.WithString("field_value", mcp.Description("Start of the value to complete for field_name, or text to filter field names by when field_name is not given")),
+	)
+	s.AddTool(jiraJQLSuggestTool, util.ErrorGuard(jiraJQLSuggestHandler))
+}
+
+// jqlParseResponse is the JQL parse response. The SDK model drops warnings,
+// so the body is decoded into this type instead.
+type jqlParseResponse struct {
+	Queries []struct {
+		Query     string   `json:"query"`
+		Errors    []string `json:"errors"`
+		Warnings  []string `json:"warnings"`
+		Structure *struct {
+			OrderBy *struct {
+				Fields []struct {
+					Field struct {
+						Name string `json:"name"`
+					} `json:"field"`
+					Direction string `json:"direction"`
+				} `json:"fields"`
+			} `json:"orderBy"`
+		} `json:"structure"`
+	} `json:"queries"`
+}
+
+// jqlPositionPattern matches the position Jira appends to JQL syntax errors.
+var jqlPositionPattern = regexp.MustCompile(`\(line (\d+), character (\d+)\)`)
+
+func jiraValidateJQLHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	jql, ok := request.Params.Arguments["jql"].(string)
+	if !ok {
+		return nil, fmt.Errorf("jql argument is required")
+	}
+
+	validation, _ := request.Params.Arguments["validation"].(string)
+	if validation == "" {
+		validation = "strict"
+	}
+
+	params := url.Values{}
+	params.Add("validation", validation)
+
+	parseRequest, err := client.NewRequest(ctx, http.MethodPost, "rest/api/2/jql/parse?"+params.Encode(), "", map[string]interface{}{"queries": []string{jql}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JQL parse request: %v", err)
+	}
+
+	parsed := new(jqlParseResponse)
+	response, err := client.Call(parseRequest, parsed)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to parse JQL: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to parse JQL: %v", err)
+	}
+	if len(parsed.Queries) == 0 {
+		return nil, fmt.Errorf("failed to parse JQL: empty response")
+	}
+	query := parsed.Queries[0]
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("JQL: %s\n", jql))
+	if len(query.Errors) == 0 {
+		sb.WriteString("Valid: yes\n")
+	} else {
+		sb.WriteString("Valid: no\n")
+	}
+
+	if len(query.Errors) > 0 {
+		sb.WriteString("\nErrors:\n")
+		for _, message := range query.Errors {
+			sb.WriteString(formatJQLProblem(jql, message))
+		}
+	}
+
+	if len(query.Warnings) > 0 {
+		sb.WriteString("\nWarnings:\n")
+		for _, message := range query.Warnings {
+			sb.WriteString(formatJQLProblem(jql, message))
+		}
+	}
+
+	if query.Structure != nil && query.Structure.OrderBy != nil && len(query.Structure.OrderBy.Fields) > 0 {
+		var fields []string
+		for _, field := range query.Structure.OrderBy.Fields {
+			order := field.Field.Name
+			if field.Direction != "" {
+				order += " " + strings.ToUpper(field.Direction)
+			}
+			fields = append(fields, order)
+		}
+		sb.WriteString(fmt.Sprintf("\nOrder by: %s\n", strings.Join(fields, ", ")))
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// formatJQLProblem renders a parse error or warning. When Jira reports a
+// position on a line of the query, the line is quoted with a marker under
+// the offending character.
+func formatJQLProblem(jql, message string) string {
+	match := jqlPositionPattern.FindStringSubmatch(message)
+	if match == nil {
+		return fmt.Sprintf("- %s\n", message)
+	}
+
+	line, _ := strconv.Atoi(match[1])
+	character, _ := strconv.Atoi(match[2])
+
+	result := fmt.Sprintf("- Line %d, character %d: %s\n", line, character, message)
+	lines := strings.Split(jql, "\n")
+	if line < 1 || line > len(lines) || character < 1 {
+		return result
+	}
+	text := []rune(lines[line-1])
+	if character > len(text)+1 {
+		return result
+	}
+	return result + fmt.Sprintf("  %s\n  %s^\n", string(text), strings.Repeat(" ", character-1))
+}
+
+type jqlAutocompleteData struct {
+	VisibleFieldNames []struct {
+		Value       string   `json:"value"`
+		DisplayName string   `json:"displayName"`
+		Orderable   string   `json:"orderable"`
+		Searchable  string   `json:"searchable"`
+		CfID        string   `json:"cfid"`
+		Operators   []string `json:"operators"`
+		Types       []string `json:"types"`
+	} `json:"visibleFieldNames"`
+	VisibleFunctionNames []struct {
+		Value       string   `json:"value"`
+		DisplayName string   `json:"displayName"`
+		IsList      string   `json:"isList"`
+		Types       []string `json:"types"`
+	} `json:"visibleFunctionNames"`
+	JqlReservedWords []string `json:"jqlReservedWords"`
+}
+
+type jqlSuggestions struct {
+	Results []struct {
+		Value       string `json:"value"`
+		DisplayName string `json:"displayName"`
+	} `json:"results"`
+}
+
+// jqlAutocompleteCache holds the JQL field and function list, which only
+// changes when fields are added to the instance.
+var jqlAutocompleteCache cachedValue[*jqlAutocompleteData]
+
+func getJQLAutocompleteData(ctx context.Context) (*jqlAutocompleteData, error) {
+	return jqlAutocompleteCache.get(func() (*jqlAutocompleteData, error) {
+		client := services.JiraClient()
+
+		autocompleteRequest, err := client.NewRequest(ctx, http.MethodGet, "rest/api/2/jql/autocompletedata", "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create JQL autocomplete request: %v", err)
+		}
+
+		data := new(jqlAutocompleteData)
+		response, err := client.Call(autocompleteRequest, data)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get JQL autocomplete data: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get JQL autocomplete data: %v", err)
+		}
+		return data, nil
+	})
+}
+
+func jiraJQLSuggestHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	fieldName, _ := request.Params.Arguments["field_name"].(string)
+	fieldValue, _ := request.Params.Arguments["field_value"].(string)
+
+	data, err := getJQLAutocompleteData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+
+	if fieldName == "" {
+		query := strings.ToLower(strings.TrimSpace(fieldValue))
+
+		sb.WriteString("Fields:\n")
+		count := 0
+		for _, field := range data.VisibleFieldNames {
+			if query != "" && !strings.Contains(strings.ToLower(field.Value), query) && !strings.Contains(strings.ToLower(field.DisplayName), query) {
+				continue
+			}
+			count++
+			line := fmt.Sprintf("- %s", field.Value)
+			if field.CfID != "" {
+				line += fmt.Sprintf(" (%s)", field.CfID)
+			}
+			line += fmt.Sprintf(" | Operators: %s", strings.Join(field.Operators, " "))
+			if field.Orderable == "true" {
+				line += " | Orderable"
+			}
+			sb.WriteString(line + "\n")
+		}
+		if count == 0 {
+			sb.WriteString("No fields found matching the criteria.\n")
+		}
+
+		sb.WriteString("\nFunctions:\n")
+		for _, function := range data.VisibleFunctionNames {
+			if query != "" && !strings.Contains(strings.ToLower(function.Value), query) {
+				continue
+			}
+			line := fmt.Sprintf("- %s", function.Value)
+			if function.IsList == "true" {
+				line += " | Returns a list, use with IN"
+			}
+			sb.WriteString(line + "\n")
+		}
+
+		return mcp.NewToolResultText(sb.String()), nil
+	}
+
+	for _, field := range data.VisibleFieldNames {
+		if strings.EqualFold(field.Value, fieldName) || (field.CfID != "" && strings.EqualFold(field.CfID, fieldName)) {
+			sb.WriteString(fmt.Sprintf("Field: %s\nOperators: %s\n", field.Value, strings.Join(field.Operators, " ")))
+			break
+		}
+	}
+
+	params := url.Values{}
+	params.Add("fieldName", fieldName)
+	if fieldValue != "" {
+		params.Add("fieldValue", fieldValue)
+	}
+
+	suggestRequest, err := client.NewRequest(ctx, http.MethodGet, "rest/api/2/jql/autocompletedata/suggestions?"+params.Encode(), "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JQL suggestions request: %v", err)
+	}
+
+	suggestions := new(jqlSuggestions)
+	response, err := client.Call(suggestRequest, suggestions)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get JQL suggestions: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get JQL suggestions: %v", err)
+	}
+
+	if len(suggestions.Results) == 0 {
+		sb.WriteString("No value suggestions found.\n")
+		return mcp.NewToolResultText(sb.String()), nil
+	}
+
+	sb.WriteString("Value suggestions:\n")
+	for _, result := range suggestions.Results {
+		// Display names highlight the matched text with <b> tags.
+		displayName := strings.NewReplacer("<b>", "", "</b>", "").Replace(result.DisplayName)
+		line := fmt.Sprintf("- %s", quoteJQL(result.Value))
+		if displayName != "" && displayName != result.Value {
+			line += fmt.Sprintf(" (%s)", displayName)
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
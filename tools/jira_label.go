@@ -0,0 +1,166 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraLabelTool(s *server.MCPServer) {
+	jiraAddLabelsTool := mcp.NewTool("jira_add_labels",
+		mcp.WithDescription("Add labels to a Jira issue without touching its other labels"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+		mcp.WithString("labels", mcp.Required(), mcp.Description("Comma-separated labels to add (e.g., backend,needs-triage). Labels cannot contain spaces")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraAddLabelsTool, util.ErrorGuard(jiraAddLabelsHandler))
+	}
+
+	jiraRemoveLabelsTool := mcp.NewTool("jira_remove_labels",
+		mcp.WithDescription("Remove labels from a Jira issue without touching its other labels"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+		mcp.WithString("labels", mcp.Required(), mcp.Description("Comma-separated labels to remove (e.g., needs-triage)")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraRemoveLabelsTool, util.ErrorGuard(jiraRemoveLabelsHandler))
+	}
+
+	jiraListLabelsTool := mcp.NewTool("jira_list_labels",
+		mcp.WithDescription("List the labels used across the Jira instance, optionally filtered by prefix"),
+		mcp.WithString("prefix", mcp.Description("Only return labels starting with this text, case-insensitive (e.g., team-)")),
+		mcp.WithString("limit", mcp.Description("Maximum number of labels to return (default: 200)")),
+	)
+	s.AddTool(jiraListLabelsTool, util.ErrorGuard(jiraListLabelsHandler))
+}
+
+func jiraAddLabelsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return updateIssueLabels(ctx, request, "add")
+}
+
+func jiraRemoveLabelsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return updateIssueLabels(ctx, request, "remove")
+}
+
+// updateIssueLabels applies the add or remove verb for each label, so labels
+// set concurrently by someone else are preserved.
+func updateIssueLabels(ctx context.Context, request mcp.CallToolRequest, verb string) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	labelsArg, ok := request.Params.Arguments["labels"].(string)
+	if !ok {
+		return nil, fmt.Errorf("labels argument is required")
+	}
+
+	labels := splitList(labelsArg)
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("labels argument is required")
+	}
+
+	mapping := make(map[string]string, len(labels))
+	for _, label := range labels {
+		if strings.ContainsAny(label, " \t") {
+			return nil, fmt.Errorf("invalid label %q: labels cannot contain spaces", label)
+		}
+		mapping[label] = verb
+	}
+
+	operations := &models.UpdateOperations{}
+	if err := operations.AddArrayOperation("labels", mapping); err != nil {
+		return nil, fmt.Errorf("failed to build label update: %v", err)
+	}
+
+	response, err := client.Issue.Update(ctx, issueKey, true, &models.IssueSchemeV2{}, nil, operations)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to update labels: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to update labels: %v", err)
+	}
+
+	issue, response, err := client.Issue.Get(ctx, issueKey, []string{"labels"}, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("labels were updated but the issue could not be reloaded: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("labels were updated but the issue could not be reloaded: %v", err)
+	}
+
+	current := "None"
+	if len(issue.Fields.Labels) > 0 {
+		current = strings.Join(issue.Fields.Labels, ", ")
+	}
+
+	action := "added to"
+	if verb == "remove" {
+		action = "removed from"
+	}
+
+	result := fmt.Sprintf("Labels %s %s: %s\nCurrent labels: %s", action, issueKey, strings.Join(labels, ", "), current)
+	return mcp.NewToolResultText(result), nil
+}
+
+func jiraListLabelsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	prefix, _ := request.Params.Arguments["prefix"].(string)
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+
+	limit, err := positiveIntArgument(request, "limit", 200)
+	if err != nil {
+		return nil, err
+	}
+
+	var labels []string
+	truncated := false
+	for startAt := 0; ; {
+		page, response, err := client.Issue.Label.Gets(ctx, startAt, 1000)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get labels: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get labels: %v", err)
+		}
+
+		for _, label := range page.Values {
+			if prefix != "" && !strings.HasPrefix(strings.ToLower(label), prefix) {
+				continue
+			}
+			if len(labels) == limit {
+				truncated = true
+				break
+			}
+			labels = append(labels, label)
+		}
+
+		if truncated || page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+
+	if len(labels) == 0 {
+		return mcp.NewToolResultText("No labels found."), nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Labels (%d):\n", len(labels)))
+	for _, label := range labels {
+		sb.WriteString(fmt.Sprintf("- %s\n", label))
+	}
+	if truncated {
+		sb.WriteString(fmt.Sprintf("\nOnly the first %d labels are shown, narrow the prefix or raise the limit to see more.\n", limit))
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
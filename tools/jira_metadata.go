@@ -0,0 +1,255 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraMetadataTool(s *server.MCPServer) {
+	jiraListPrioritiesTool := mcp.NewTool("jira_list_priorities",
+		mcp.WithDescription("List the issue priorities of the Jira instance, for use in JQL and issue payloads"),
+	)
+	s.AddTool(jiraListPrioritiesTool, util.ErrorGuard(jiraListPrioritiesHandler))
+
+	jiraListResolutionsTool := mcp.NewTool("jira_list_resolutions",
+		mcp.WithDescription("List the issue resolutions of the Jira instance, for use in JQL and transitions"),
+	)
+	s.AddTool(jiraListResolutionsTool, util.ErrorGuard(jiraListResolutionsHandler))
+
+	jiraListIssueTypesTool := mcp.NewTool("jira_list_issue_types",
+		mcp.WithDescription("List the issue types of the Jira instance with their hierarchy level (epic, standard, subtask) and, for team-managed projects, the project they belong to"),
+	)
+	s.AddTool(jiraListIssueTypesTool, util.ErrorGuard(jiraListIssueTypesHandler))
+
+	jiraListFieldsTool := mcp.NewTool("jira_list_fields",
+		mcp.WithDescription("List the system and custom fields of the Jira instance with their IDs, schema types and JQL clause names"),
+		mcp.WithString("query", mcp.Description("Only fields whose name or ID contains this text, case-insensitive (e.g., story)")),
+		mcp.WithString("type", mcp.Description("Only system or custom fields (default: all)"), mcp.Enum("all", "system", "custom")),
+	)
+	s.AddTool(jiraListFieldsTool, util.ErrorGuard(jiraListFieldsHandler))
+}
+
+// cachedValue holds instance metadata that does not change while the server
+// runs. Unlike sync.OnceValue, a failed load is retried on the next call.
+type cachedValue[T any] struct {
+	mu     sync.Mutex
+	loaded bool
+	value  T
+}
+
+func (c *cachedValue[T]) get(load func() (T, error)) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		value, err := load()
+		if err != nil {
+			return value, err
+		}
+		c.value, c.loaded = value, true
+	}
+	return c.value, nil
+}
+
+var (
+	priorityCache   cachedValue[[]*models.PriorityScheme]
+	resolutionCache cachedValue[[]*models.ResolutionScheme]
+	issueTypeCache  cachedValue[[]*models.IssueTypeScheme]
+	fieldCache      cachedValue[[]*models.IssueFieldScheme]
+)
+
+func getPriorities(ctx context.Context) ([]*models.PriorityScheme, error) {
+	return priorityCache.get(func() ([]*models.PriorityScheme, error) {
+		priorities, response, err := services.JiraClient().Issue.Priority.Gets(ctx)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get priorities: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get priorities: %v", err)
+		}
+		return priorities, nil
+	})
+}
+
+func getResolutions(ctx context.Context) ([]*models.ResolutionScheme, error) {
+	return resolutionCache.get(func() ([]*models.ResolutionScheme, error) {
+		resolutions, response, err := services.JiraClient().Issue.Resolution.Gets(ctx)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get resolutions: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get resolutions: %v", err)
+		}
+		return resolutions, nil
+	})
+}
+
+func getIssueTypes(ctx context.Context) ([]*models.IssueTypeScheme, error) {
+	return issueTypeCache.get(func() ([]*models.IssueTypeScheme, error) {
+		issueTypes, response, err := services.JiraClient().Issue.Type.Gets(ctx)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get issue types: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get issue types: %v", err)
+		}
+		return issueTypes, nil
+	})
+}
+
+func getFields(ctx context.Context) ([]*models.IssueFieldScheme, error) {
+	return fieldCache.get(func() ([]*models.IssueFieldScheme, error) {
+		fields, response, err := services.JiraClient().Issue.Field.Gets(ctx)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get fields: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get fields: %v", err)
+		}
+		return fields, nil
+	})
+}
+
+func jiraListPrioritiesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	priorities, err := getPriorities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(priorities) == 0 {
+		return mcp.NewToolResultText("No priorities found."), nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Priorities (highest first):\n")
+	for _, priority := range priorities {
+		line := fmt.Sprintf("- %s (ID: %s)", priority.Name, priority.ID)
+		if priority.Description != "" {
+			line += ": " + priority.Description
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func jiraListResolutionsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	resolutions, err := getResolutions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resolutions) == 0 {
+		return mcp.NewToolResultText("No resolutions found."), nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Resolutions:\n")
+	for _, resolution := range resolutions {
+		line := fmt.Sprintf("- %s (ID: %s)", resolution.Name, resolution.ID)
+		if resolution.Description != "" {
+			line += ": " + resolution.Description
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func jiraListIssueTypesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	issueTypes, err := getIssueTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(issueTypes) == 0 {
+		return mcp.NewToolResultText("No issue types found."), nil
+	}
+
+	// Highest hierarchy level first: epics, then standard types, then subtasks.
+	sorted := append([]*models.IssueTypeScheme(nil), issueTypes...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].HierarchyLevel > sorted[j].HierarchyLevel })
+
+	var sb strings.Builder
+	sb.WriteString("Issue Types:\n")
+	for _, issueType := range sorted {
+		line := fmt.Sprintf("- %s (ID: %s) | Level: %d (%s)", issueType.Name, issueType.ID, issueType.HierarchyLevel, issueTypeLevelName(issueType))
+		if issueType.Subtask {
+			line += " | Subtask"
+		}
+		if issueType.Scope != nil && issueType.Scope.Project != nil {
+			line += fmt.Sprintf(" | Project ID: %s", issueType.Scope.Project.ID)
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// issueTypeLevelName names the hierarchy level of an issue type.
+func issueTypeLevelName(issueType *models.IssueTypeScheme) string {
+	switch {
+	case issueType.Subtask || issueType.HierarchyLevel < 0:
+		return "subtask"
+	case issueType.HierarchyLevel == 0:
+		return "standard"
+	case issueType.HierarchyLevel == 1:
+		return "epic"
+	}
+	return "above epic"
+}
+
+func jiraListFieldsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	fields, err := getFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, _ := request.Params.Arguments["query"].(string)
+	query = strings.ToLower(strings.TrimSpace(query))
+
+	fieldType, _ := request.Params.Arguments["type"].(string)
+
+	var sb strings.Builder
+	count := 0
+	for _, field := range fields {
+		if (fieldType == "system" && field.Custom) || (fieldType == "custom" && !field.Custom) {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(field.Name), query) && !strings.Contains(strings.ToLower(field.ID), query) {
+			continue
+		}
+
+		count++
+		line := fmt.Sprintf("- %s (ID: %s)", field.Name, field.ID)
+		if field.Custom {
+			line += " | Custom"
+		}
+		if field.Schema != nil && field.Schema.Type != "" {
+			schema := field.Schema.Type
+			if field.Schema.Items != "" {
+				schema += " of " + field.Schema.Items
+			}
+			line += " | Type: " + schema
+		}
+		if len(field.ClauseNames) > 0 {
+			line += " | JQL: " + strings.Join(field.ClauseNames, ", ")
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	if count == 0 {
+		return mcp.NewToolResultText("No fields found matching the criteria."), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Fields (%d):\n%s", count, sb.String())), nil
+}
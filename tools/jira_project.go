@@ -0,0 +1,222 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraProjectTool(s *server.MCPServer) {
+	jiraListProjectsTool := mcp.NewTool("jira_list_projects",
+		mcp.WithDescription("Find Jira projects by name, key or category. Returns project keys for use with the other tools"),
+		mcp.WithString("query", mcp.Description("Only projects whose key or name contains this text (case-insensitive)")),
+		mcp.WithString("category", mcp.Description("Only projects in this project category, by name or ID")),
+		mcp.WithString("type", mcp.Description("Only projects of this type"), mcp.Enum("software", "business", "service_desk")),
+		mcp.WithString("start_at", mcp.Description("Index of the first project to return, for pagination (default: 0)")),
+		mcp.WithString("max_results", mcp.Description("Maximum number of projects to return (default: 50)")),
+	)
+	s.AddTool(jiraListProjectsTool, util.ErrorGuard(jiraListProjectsHandler))
+
+	jiraGetProjectTool := mcp.NewTool("jira_get_project",
+		mcp.WithDescription("Retrieve a Jira project's details: lead, project type, default assignee, issue types, components and versions"),
+		mcp.WithString("project_key", mcp.Required(), mcp.Description("Project identifier (e.g., KP, PROJ)")),
+	)
+	s.AddTool(jiraGetProjectTool, util.ErrorGuard(jiraGetProjectHandler))
+}
+
+func jiraListProjectsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	startAt, err := intArgument(request, "start_at", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults, err := positiveIntArgument(request, "max_results", 50)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &models.ProjectSearchOptionsScheme{
+		OrderBy: "key",
+		Expand:  []string{"description", "lead"},
+	}
+	if query, ok := request.Params.Arguments["query"].(string); ok {
+		options.Query = query
+	}
+	if projectType, ok := request.Params.Arguments["type"].(string); ok && projectType != "" {
+		options.TypeKeys = []string{projectType}
+	}
+	if category, ok := request.Params.Arguments["category"].(string); ok && category != "" {
+		categoryID, err := findProjectCategoryID(ctx, category)
+		if err != nil {
+			return nil, err
+		}
+		options.CategoryID = categoryID
+	}
+
+	projects, response, err := client.Project.Search(ctx, options, startAt, maxResults)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to search projects: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to search projects: %v", err)
+	}
+
+	if len(projects.Values) == 0 {
+		return mcp.NewToolResultText("No projects found matching the criteria."), nil
+	}
+
+	var sb strings.Builder
+	for _, project := range projects.Values {
+		sb.WriteString(fmt.Sprintf("Key: %s\nName: %s\nType: %s\n", project.Key, project.Name, project.ProjectTypeKey))
+		if project.Category != nil {
+			sb.WriteString(fmt.Sprintf("Category: %s\n", project.Category.Name))
+		}
+		if project.Lead != nil {
+			sb.WriteString(fmt.Sprintf("Lead: %s\n", project.Lead.DisplayName))
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("Showing %d-%d of %d projects", startAt+1, startAt+len(projects.Values), projects.Total))
+	if !projects.IsLast {
+		sb.WriteString(fmt.Sprintf(", use start_at=%d for the next page", startAt+len(projects.Values)))
+	}
+	sb.WriteString("\n")
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// findProjectCategoryID resolves a project category given by ID or name.
+func findProjectCategoryID(ctx context.Context, category string) (int, error) {
+	if id, err := strconv.Atoi(category); err == nil {
+		return id, nil
+	}
+
+	categories, response, err := services.JiraClient().Project.Category.Gets(ctx)
+	if err != nil {
+		if response != nil {
+			return 0, fmt.Errorf("failed to get project categories: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return 0, fmt.Errorf("failed to get project categories: %v", err)
+	}
+
+	var names []string
+	for _, candidate := range categories {
+		if strings.EqualFold(candidate.Name, category) {
+			return strconv.Atoi(candidate.ID)
+		}
+		names = append(names, candidate.Name)
+	}
+
+	return 0, fmt.Errorf("project category %q not found, available categories: %s", category, strings.Join(names, ", "))
+}
+
+func jiraGetProjectHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	projectKey, ok := request.Params.Arguments["project_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("project_key argument is required")
+	}
+
+	project, response, err := client.Project.Get(ctx, projectKey, []string{"description", "lead", "issueTypes", "url"})
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get project: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get project: %v", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Key: %s\nName: %s\nID: %s\nType: %s\n", project.Key, project.Name, project.ID, project.ProjectTypeKey))
+	if project.Style != "" {
+		sb.WriteString(fmt.Sprintf("Style: %s\n", project.Style))
+	}
+	if project.Category != nil {
+		sb.WriteString(fmt.Sprintf("Category: %s\n", project.Category.Name))
+	}
+	if project.Lead != nil {
+		sb.WriteString(fmt.Sprintf("Lead: %s\n", formatUser(project.Lead)))
+	}
+	if project.AssigneeType != "" {
+		sb.WriteString(fmt.Sprintf("Default assignee: %s\n", projectAssigneeType(project.AssigneeType)))
+	}
+	if project.URL != "" {
+		sb.WriteString(fmt.Sprintf("URL: %s\n", project.URL))
+	}
+	if project.Description != "" {
+		sb.WriteString(fmt.Sprintf("Description: %s\n", project.Description))
+	}
+
+	if len(project.IssueTypes) > 0 {
+		sb.WriteString("\nIssue Types:\n")
+		for _, issueType := range project.IssueTypes {
+			line := fmt.Sprintf("- %s (ID: %s)", issueType.Name, issueType.ID)
+			if issueType.Subtask {
+				line += " [subtask]"
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+
+	if len(project.Components) > 0 {
+		sb.WriteString("\nComponents:\n")
+		for _, component := range project.Components {
+			line := fmt.Sprintf("- %s (ID: %s)", component.Name, component.ID)
+			if component.Lead != nil {
+				line += " | Lead: " + component.Lead.DisplayName
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+
+	if len(project.Versions) > 0 {
+		sb.WriteString("\nVersions:\n")
+		for _, version := range project.Versions {
+			sb.WriteString(fmt.Sprintf("- %s (ID: %s) | %s", version.Name, version.ID, versionState(version)))
+			if version.ReleaseDate != "" {
+				sb.WriteString(" | Release date: " + version.ReleaseDate)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// projectAssigneeType describes a project or component default assignee type.
+func projectAssigneeType(assigneeType string) string {
+	switch assigneeType {
+	case "PROJECT_LEAD":
+		return "Project lead"
+	case "COMPONENT_LEAD":
+		return "Component lead"
+	case "UNASSIGNED":
+		return "Unassigned"
+	case "PROJECT_DEFAULT":
+		return "Project default"
+	}
+	return assigneeType
+}
+
+// versionState describes whether a version is released, archived or overdue.
+func versionState(version *models.VersionScheme) string {
+	switch {
+	case version.Archived:
+		return "archived"
+	case version.Released:
+		return "released"
+	case version.Overdue:
+		return "unreleased (overdue)"
+	}
+	return "unreleased"
+}
@@ -0,0 +1,216 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraReleaseNotesTool(s *server.MCPServer) {
+	jiraReleaseNotesTool := mcp.NewTool("jira_release_notes",
+		mcp.WithDescription("Generate Markdown release notes for a fix version, grouping its issues by issue type, label or component. Issues with the internal label are left out"),
+		mcp.WithString("project_key", mcp.Required(), mcp.Description("Project identifier (e.g., KP, PROJ)")),
+		mcp.WithString("version", mcp.Required(), mcp.Description("Name or ID of the fix version (e.g., 1.4.0)")),
+		mcp.WithString("group_by", mcp.Description("What sections are based on (default: issuetype)"), mcp.Enum("issuetype", "label", "component")),
+		mcp.WithString("sections", mcp.Description("Optional JSON array of sections in display order, each mapping group_by values to a heading, e.g. [{\"title\": \"New features\", \"values\": [\"Story\", \"Epic\"]}, {\"title\": \"Bug fixes\", \"values\": [\"Bug\"]}]. Issues matching no section are listed under \"Other changes\". Default: one section per value")),
+		mcp.WithString("exclude_label", mcp.Description("Issues with this label are left out (default: internal, empty string to include everything)")),
+		mcp.WithBoolean("include_json", mcp.Description("Also return the release notes as JSON (default: false)")),
+	)
+	s.AddTool(jiraReleaseNotesTool, util.ErrorGuard(jiraReleaseNotesHandler))
+}
+
+// releaseNotesSection is a heading of the release notes and the group_by
+// values whose issues are listed under it.
+type releaseNotesSection struct {
+	Title  string              `json:"title"`
+	Values []string            `json:"values,omitempty"`
+	Issues []*releaseNotesItem `json:"issues"`
+}
+
+type releaseNotesItem struct {
+	Key     string `json:"key"`
+	Summary string `json:"summary"`
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	URL     string `json:"url"`
+}
+
+type releaseNotes struct {
+	Project     string                 `json:"project"`
+	Version     string                 `json:"version"`
+	ReleaseDate string                 `json:"release_date,omitempty"`
+	Sections    []*releaseNotesSection `json:"sections"`
+}
+
+func jiraReleaseNotesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectKey, ok := request.Params.Arguments["project_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("project_key argument is required")
+	}
+
+	versionArg, ok := request.Params.Arguments["version"].(string)
+	if !ok {
+		return nil, fmt.Errorf("version argument is required")
+	}
+
+	groupBy, _ := request.Params.Arguments["group_by"].(string)
+	if groupBy == "" {
+		groupBy = "issuetype"
+	}
+
+	excludeLabel := "internal"
+	if label, ok := request.Params.Arguments["exclude_label"].(string); ok {
+		excludeLabel = label
+	}
+
+	var sections []*releaseNotesSection
+	if sectionsArg, ok := request.Params.Arguments["sections"].(string); ok && sectionsArg != "" {
+		if err := json.Unmarshal([]byte(sectionsArg), &sections); err != nil {
+			return nil, fmt.Errorf("invalid sections JSON: %v", err)
+		}
+	}
+
+	includeJSON, _ := request.Params.Arguments["include_json"].(bool)
+
+	version, err := findVersion(ctx, projectKey, versionArg)
+	if err != nil {
+		return nil, err
+	}
+
+	jql := fmt.Sprintf("project = %s AND fixVersion = %s", quoteJQL(projectKey), version.ID)
+	if excludeLabel != "" {
+		jql += fmt.Sprintf(" AND (labels IS EMPTY OR labels != %s)", quoteJQL(excludeLabel))
+	}
+	jql += " ORDER BY issuetype ASC, key ASC"
+
+	issues, err := searchAllIssues(ctx, jql, []string{"summary", "issuetype", "status", "labels", "components"}, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	notes := &releaseNotes{Project: projectKey, Version: version.Name, ReleaseDate: version.ReleaseDate}
+
+	// Without configured sections every distinct value gets its own section.
+	if len(sections) == 0 {
+		seen := map[string]bool{}
+		var values []string
+		for _, issue := range issues {
+			for _, value := range releaseNotesValues(issue.Issue, groupBy) {
+				if !seen[value] {
+					seen[value] = true
+					values = append(values, value)
+				}
+			}
+		}
+		sort.Strings(values)
+		for _, value := range values {
+			sections = append(sections, &releaseNotesSection{Title: value, Values: []string{value}})
+		}
+	}
+	other := &releaseNotesSection{Title: "Other changes"}
+
+	var unresolved int
+	for _, issue := range issues {
+		item := &releaseNotesItem{
+			Key:     issue.Issue.Key,
+			Summary: issue.Issue.Fields.Summary,
+			URL:     services.JiraClient().Site.JoinPath("browse", issue.Issue.Key).String(),
+		}
+		if issue.Issue.Fields.IssueType != nil {
+			item.Type = issue.Issue.Fields.IssueType.Name
+		}
+		if issue.Issue.Fields.Status != nil {
+			item.Status = issue.Issue.Fields.Status.Name
+		}
+		if issueStatusCategory(issue.Issue) != "done" {
+			unresolved++
+		}
+
+		// An issue with several labels or components is listed once, under
+		// the first section that matches.
+		section := releaseNotesSectionFor(sections, releaseNotesValues(issue.Issue, groupBy))
+		if section == nil {
+			section = other
+		}
+		section.Issues = append(section.Issues, item)
+	}
+
+	for _, section := range append(sections, other) {
+		if len(section.Issues) > 0 {
+			notes.Sections = append(notes.Sections, section)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Release notes: %s %s\n", projectKey, version.Name))
+	if version.ReleaseDate != "" {
+		sb.WriteString(fmt.Sprintf("\nRelease date: %s\n", version.ReleaseDate))
+	}
+	if version.Description != "" {
+		sb.WriteString(fmt.Sprintf("\n%s\n", version.Description))
+	}
+
+	if len(notes.Sections) == 0 {
+		sb.WriteString("\nNo issues in this version.\n")
+	}
+	for _, section := range notes.Sections {
+		sb.WriteString(fmt.Sprintf("\n## %s\n\n", section.Title))
+		for _, item := range section.Issues {
+			sb.WriteString(fmt.Sprintf("- [%s](%s) %s\n", item.Key, item.URL, item.Summary))
+		}
+	}
+
+	if unresolved > 0 {
+		sb.WriteString(fmt.Sprintf("\nWarning: %d of %d issues are not done yet.\n", unresolved, len(issues)))
+	}
+
+	if includeJSON {
+		data, err := toJSON(notes)
+		if err != nil {
+			return nil, err
+		}
+		sb.WriteString("\n```json\n" + data + "\n```\n")
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// releaseNotesValues returns the values of the group_by field of an issue.
+func releaseNotesValues(issue *models.IssueSchemeV2, groupBy string) []string {
+	switch groupBy {
+	case "label":
+		return issue.Fields.Labels
+	case "component":
+		var names []string
+		for _, component := range issue.Fields.Components {
+			names = append(names, component.Name)
+		}
+		return names
+	}
+	if issue.Fields.IssueType == nil {
+		return nil
+	}
+	return []string{issue.Fields.IssueType.Name}
+}
+
+// releaseNotesSectionFor returns the first section listing one of the values.
+func releaseNotesSectionFor(sections []*releaseNotesSection, values []string) *releaseNotesSection {
+	for _, section := range sections {
+		for _, candidate := range section.Values {
+			for _, value := range values {
+				if strings.EqualFold(candidate, value) {
+					return section
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraRemoteLinkTool(s *server.MCPServer) {
+	jiraListRemoteLinksTool := mcp.NewTool("jira_list_remote_links",
+		mcp.WithDescription("List the remote (web) links of a Jira issue, such as pull requests, runbooks and dashboards"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+	)
+	s.AddTool(jiraListRemoteLinksTool, util.ErrorGuard(jiraListRemoteLinksHandler))
+
+	jiraCreateRemoteLinkTool := mcp.NewTool("jira_create_remote_link",
+		mcp.WithDescription("Attach a remote (web) link such as a pull request URL to a Jira issue. Linking the same URL again updates the existing link instead of duplicating it"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+		mcp.WithString("url", mcp.Required(), mcp.Description("URL of the linked resource")),
+		mcp.WithString("title", mcp.Required(), mcp.Description("Text shown for the link (e.g., PR #42: Fix login)")),
+		mcp.WithString("summary", mcp.Description("Optional short description shown next to the link")),
+		mcp.WithString("icon_url", mcp.Description("Optional URL of a 16x16 icon for the link")),
+		mcp.WithString("relationship", mcp.Description("Optional relationship label used to group links (e.g., \"pull request\", \"runbook\"). Defaults to \"links to\"")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraCreateRemoteLinkTool, util.ErrorGuard(jiraCreateRemoteLinkHandler))
+	}
+
+	jiraUpdateRemoteLinkTool := mcp.NewTool("jira_update_remote_link",
+		mcp.WithDescription("Update a remote link of a Jira issue. Only specified fields will be changed"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+		mcp.WithString("link_id", mcp.Required(), mcp.Description("ID of the remote link, from jira_list_remote_links")),
+		mcp.WithString("url", mcp.Description("New URL of the linked resource (optional)")),
+		mcp.WithString("title", mcp.Description("New link text (optional)")),
+		mcp.WithString("summary", mcp.Description("New short description (optional)")),
+		mcp.WithString("icon_url", mcp.Description("New 16x16 icon URL (optional)")),
+		mcp.WithString("relationship", mcp.Description("New relationship label (optional)")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraUpdateRemoteLinkTool, util.ErrorGuard(jiraUpdateRemoteLinkHandler))
+	}
+
+	jiraDeleteRemoteLinkTool := mcp.NewTool("jira_delete_remote_link",
+		mcp.WithDescription("Remove a remote link from a Jira issue"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+		mcp.WithString("link_id", mcp.Required(), mcp.Description("ID of the remote link, from jira_list_remote_links")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraDeleteRemoteLinkTool, util.ErrorGuard(jiraDeleteRemoteLinkHandler))
+	}
+}
+
+func jiraListRemoteLinksHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	links, response, err := client.Issue.Link.Remote.Gets(ctx, issueKey, "")
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get remote links: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get remote links: %v", err)
+	}
+
+	if len(links) == 0 {
+		return mcp.NewToolResultText("No remote links found for this issue."), nil
+	}
+
+	var sb strings.Builder
+	for _, link := range links {
+		sb.WriteString(formatRemoteLink(link))
+		sb.WriteString("\n")
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func jiraCreateRemoteLinkHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	linkURL, ok := request.Params.Arguments["url"].(string)
+	if !ok || linkURL == "" {
+		return nil, fmt.Errorf("url argument is required")
+	}
+
+	title, ok := request.Params.Arguments["title"].(string)
+	if !ok || title == "" {
+		return nil, fmt.Errorf("title argument is required")
+	}
+
+	// Using the URL as global ID makes re-linking the same resource an update.
+	payload := &models.RemoteLinkScheme{
+		GlobalID: linkURL,
+		Object: &models.RemoteLinkObjectScheme{
+			URL:   linkURL,
+			Title: title,
+		},
+	}
+	applyRemoteLinkArguments(payload, request.Params.Arguments)
+
+	link, response, err := client.Issue.Link.Remote.Create(ctx, issueKey, payload)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to create remote link: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to create remote link: %v", err)
+	}
+
+	result := fmt.Sprintf("Remote link saved successfully!\nIssue: %s\nLink ID: %d\nTitle: %s\nURL: %s", issueKey, link.ID, title, linkURL)
+	return mcp.NewToolResultText(result), nil
+}
+
+func jiraUpdateRemoteLinkHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	linkID, ok := request.Params.Arguments["link_id"].(string)
+	if !ok || linkID == "" {
+		return nil, fmt.Errorf("link_id argument is required")
+	}
+
+	// Jira clears any field missing from an update, so start from the current link.
+	link, response, err := client.Issue.Link.Remote.Get(ctx, issueKey, linkID)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get remote link: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get remote link: %v", err)
+	}
+
+	if link.Object == nil {
+		link.Object = &models.RemoteLinkObjectScheme{}
+	}
+	if linkURL, ok := request.Params.Arguments["url"].(string); ok && linkURL != "" {
+		// Links made by jira_create_remote_link use the URL as global ID, so
+		// keep it in step to avoid duplicates. Global IDs owned by other apps
+		// are left alone.
+		if link.GlobalID == link.Object.URL {
+			link.GlobalID = linkURL
+		}
+		link.Object.URL = linkURL
+	}
+	if title, ok := request.Params.Arguments["title"].(string); ok && title != "" {
+		link.Object.Title = title
+	}
+	applyRemoteLinkArguments(link, request.Params.Arguments)
+
+	payload := &models.RemoteLinkScheme{
+		GlobalID:     link.GlobalID,
+		Application:  link.Application,
+		Relationship: link.Relationship,
+		Object:       link.Object,
+	}
+
+	response, err = client.Issue.Link.Remote.Update(ctx, issueKey, linkID, payload)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to update remote link: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to update remote link: %v", err)
+	}
+
+	return mcp.NewToolResultText("Remote link updated successfully!\n" + formatRemoteLink(link)), nil
+}
+
+func jiraDeleteRemoteLinkHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	linkID, ok := request.Params.Arguments["link_id"].(string)
+	if !ok || linkID == "" {
+		return nil, fmt.Errorf("link_id argument is required")
+	}
+
+	response, err := client.Issue.Link.Remote.DeleteById(ctx, issueKey, linkID)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to delete remote link: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to delete remote link: %v", err)
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Remote link %s deleted from %s", linkID, issueKey)), nil
+}
+
+// applyRemoteLinkArguments copies the optional summary, icon_url and
+// relationship arguments onto a remote link payload.
+func applyRemoteLinkArguments(link *models.RemoteLinkScheme, arguments map[string]interface{}) {
+	if summary, ok := arguments["summary"].(string); ok && summary != "" {
+		link.Object.Summary = summary
+	}
+	if iconURL, ok := arguments["icon_url"].(string); ok && iconURL != "" {
+		link.Object.Icon = &models.RemoteLinkObjectLinkScheme{URL16X16: iconURL, Title: link.Object.Title}
+	}
+	if relationship, ok := arguments["relationship"].(string); ok && relationship != "" {
+		link.Relationship = relationship
+	}
+}
+
+func formatRemoteLink(link *models.RemoteLinkScheme) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("ID: %d\n", link.ID))
+	if link.Relationship != "" {
+		sb.WriteString(fmt.Sprintf("Relationship: %s\n", link.Relationship))
+	}
+	if link.Object != nil {
+		sb.WriteString(fmt.Sprintf("Title: %s\nURL: %s\n", link.Object.Title, link.Object.URL))
+		if link.Object.Summary != "" {
+			sb.WriteString(fmt.Sprintf("Summary: %s\n", link.Object.Summary))
+		}
+		if link.Object.Status != nil && link.Object.Status.Resolved {
+			sb.WriteString("Resolved: true\n")
+		}
+	}
+	if link.Application != nil && link.Application.Name != "" {
+		sb.WriteString(fmt.Sprintf("Application: %s\n", link.Application.Name))
+	}
+	return sb.String()
+}
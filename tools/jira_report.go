@@ -0,0 +1,609 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraReportTool(s *server.MCPServer) {
+	jiraSprintReportTool := mcp.NewTool("jira_sprint_report",
+		mcp.WithDescription("Velocity report for the last N closed sprints of a board: committed vs. completed estimate, scope added and removed mid-sprint, carry-over, and average velocity, reconstructed from issue changelogs"),
+		mcp.WithString("board_id", mcp.Required(), mcp.Description("Numeric ID of the scrum board, from jira_list_boards")),
+		mcp.WithString("sprint_count", mcp.Description("Number of most recent closed sprints to include (default: 5)")),
+		mcp.WithBoolean("include_json", mcp.Description("Also return the report as JSON (default: false)")),
+	)
+	s.AddTool(jiraSprintReportTool, util.ErrorGuard(jiraSprintReportHandler))
+
+	jiraSprintBurndownTool := mcp.NewTool("jira_sprint_burndown",
+		mcp.WithDescription("Day-by-day burndown of a sprint: remaining story points (or remaining time estimate) per day with the ideal line, reconstructed from issue changelogs. Days where scope changed are flagged"),
+		mcp.WithString("board_id", mcp.Required(), mcp.Description("Numeric ID of the scrum board, from jira_list_boards")),
+		mcp.WithString("sprint_id", mcp.Description("Sprint to chart (default: the board's active sprint)")),
+		mcp.WithBoolean("include_json", mcp.Description("Also return the series as JSON (default: false)")),
+	)
+	s.AddTool(jiraSprintBurndownTool, util.ErrorGuard(jiraSprintBurndownHandler))
+}
+
+// sprintReportRow holds the scope figures of one sprint, in the board's unit.
+type sprintReportRow struct {
+	SprintID        int       `json:"sprint_id"`
+	Name            string    `json:"name"`
+	StartDate       time.Time `json:"start_date"`
+	CompleteDate    time.Time `json:"complete_date"`
+	Committed       float64   `json:"committed"`
+	CommittedIssues int       `json:"committed_issues"`
+	Added           float64   `json:"added"`
+	AddedIssues     int       `json:"added_issues"`
+	Removed         float64   `json:"removed"`
+	RemovedIssues   int       `json:"removed_issues"`
+	Completed       float64   `json:"completed"`
+	CompletedIssues int       `json:"completed_issues"`
+	CarryOver       float64   `json:"carry_over"`
+	CarryOverIssues int       `json:"carry_over_issues"`
+}
+
+type sprintReport struct {
+	BoardID         int                `json:"board_id"`
+	Board           string             `json:"board"`
+	Unit            string             `json:"unit"`
+	Sprints         []*sprintReportRow `json:"sprints"`
+	AverageVelocity float64            `json:"average_velocity"`
+	AverageCommit   float64            `json:"average_committed"`
+	Warnings        []string           `json:"warnings,omitempty"`
+}
+
+func jiraSprintReportHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	boardIDStr, ok := request.Params.Arguments["board_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("board_id argument is required")
+	}
+
+	boardID, err := strconv.Atoi(boardIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid board_id: %v", err)
+	}
+
+	sprintCount, err := positiveIntArgument(request, "sprint_count", 5)
+	if err != nil {
+		return nil, err
+	}
+
+	includeJSON, _ := request.Params.Arguments["include_json"].(bool)
+
+	config, err := getBoardConfiguration(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	sprintField, err := findFieldID(ctx, "Sprint")
+	if err != nil {
+		return nil, err
+	}
+
+	closed, err := listBoardSprints(ctx, boardID, []string{"closed"})
+	if err != nil {
+		return nil, err
+	}
+	if len(closed) == 0 {
+		return mcp.NewToolResultText("No closed sprints found for this board."), nil
+	}
+
+	sort.SliceStable(closed, func(i, j int) bool { return closed[i].CompleteDate.Before(closed[j].CompleteDate) })
+	if len(closed) > sprintCount {
+		closed = closed[len(closed)-sprintCount:]
+	}
+
+	estimationField := boardEstimationField(config)
+	report := &sprintReport{BoardID: config.ID, Board: config.Name, Unit: estimateUnit(estimationField)}
+	if estimationField == "" {
+		report.Unit = "issues"
+	}
+
+	for _, sprint := range closed {
+		scope, warning, err := getSprintScope(ctx, config, estimationField, sprint.ID, sprint.Name)
+		if err != nil {
+			return nil, err
+		}
+		if warning != "" {
+			report.Warnings = append(report.Warnings, warning)
+		}
+
+		end := sprint.CompleteDate
+		if end.IsZero() {
+			end = sprint.EndDate
+		}
+
+		row := &sprintReportRow{SprintID: sprint.ID, Name: sprint.Name, StartDate: sprint.StartDate, CompleteDate: end}
+		for _, issue := range scope {
+			outcome := replaySprintIssue(issue, config, sprintField, sprint.ID, sprint.StartDate, end)
+			if outcome.inAtStart {
+				row.Committed += outcome.startEstimate
+				row.CommittedIssues++
+			}
+			if outcome.added {
+				row.Added += outcome.endEstimate
+				row.AddedIssues++
+			}
+			if outcome.removed {
+				row.Removed += outcome.endEstimate
+				row.RemovedIssues++
+			}
+			if outcome.completed {
+				row.Completed += outcome.endEstimate
+				row.CompletedIssues++
+			} else if outcome.inAtEnd {
+				row.CarryOver += outcome.endEstimate
+				row.CarryOverIssues++
+			}
+		}
+
+		if estimationField == "" {
+			row.Committed, row.Added, row.Removed = float64(row.CommittedIssues), float64(row.AddedIssues), float64(row.RemovedIssues)
+			row.Completed, row.CarryOver = float64(row.CompletedIssues), float64(row.CarryOverIssues)
+		} else {
+			row.Committed = estimateInUnit(estimationField, row.Committed)
+			row.Added = estimateInUnit(estimationField, row.Added)
+			row.Removed = estimateInUnit(estimationField, row.Removed)
+			row.Completed = estimateInUnit(estimationField, row.Completed)
+			row.CarryOver = estimateInUnit(estimationField, row.CarryOver)
+		}
+
+		report.Sprints = append(report.Sprints, row)
+		report.AverageVelocity += row.Completed / float64(len(closed))
+		report.AverageCommit += row.Committed / float64(len(closed))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Sprint Report: %s (ID: %d)\nEstimation: %s\nUnit: %s, issue counts in parentheses\n\n", config.Name, config.ID, boardEstimationName(config), report.Unit))
+	sb.WriteString("| Sprint | Completed on | Committed | Added | Removed | Completed | Carry-over |\n")
+	sb.WriteString("|---|---|---|---|---|---|---|\n")
+	for _, row := range report.Sprints {
+		sb.WriteString(fmt.Sprintf("| %s | %s | %g (%d) | %g (%d) | %g (%d) | %g (%d) | %g (%d) |\n",
+			row.Name, row.CompleteDate.Format("2006-01-02"),
+			roundTo(row.Committed, 1), row.CommittedIssues,
+			roundTo(row.Added, 1), row.AddedIssues,
+			roundTo(row.Removed, 1), row.RemovedIssues,
+			roundTo(row.Completed, 1), row.CompletedIssues,
+			roundTo(row.CarryOver, 1), row.CarryOverIssues))
+	}
+
+	sb.WriteString(fmt.Sprintf("\nAverage velocity: %g %s per sprint\nAverage commitment: %g %s per sprint\n",
+		roundTo(report.AverageVelocity, 1), report.Unit, roundTo(report.AverageCommit, 1), report.Unit))
+	if report.AverageCommit > 0 {
+		sb.WriteString(fmt.Sprintf("Completion ratio: %.0f%%\n", report.AverageVelocity/report.AverageCommit*100))
+	}
+
+	for _, warning := range report.Warnings {
+		sb.WriteString(fmt.Sprintf("Warning: %s\n", warning))
+	}
+
+	if includeJSON {
+		data, err := toJSON(report)
+		if err != nil {
+			return nil, err
+		}
+		sb.WriteString("\n```json\n" + data + "\n```\n")
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// burndownDay is one point of a burndown series, in the board's unit.
+type burndownDay struct {
+	Date         string   `json:"date"`
+	Remaining    float64  `json:"remaining"`
+	Ideal        float64  `json:"ideal"`
+	ScopeChanged bool     `json:"scope_changed"`
+	Changes      []string `json:"changes,omitempty"`
+}
+
+type sprintBurndown struct {
+	SprintID int            `json:"sprint_id"`
+	Sprint   string         `json:"sprint"`
+	Unit     string         `json:"unit"`
+	Start    time.Time      `json:"start_date"`
+	End      time.Time      `json:"end_date"`
+	Days     []*burndownDay `json:"days"`
+	Warnings []string       `json:"warnings,omitempty"`
+}
+
+func jiraSprintBurndownHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	boardIDStr, ok := request.Params.Arguments["board_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("board_id argument is required")
+	}
+
+	boardID, err := strconv.Atoi(boardIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid board_id: %v", err)
+	}
+
+	includeJSON, _ := request.Params.Arguments["include_json"].(bool)
+
+	var sprint *models.BoardSprintScheme
+	if sprintIDStr, ok := request.Params.Arguments["sprint_id"].(string); ok && sprintIDStr != "" {
+		sprintID, err := strconv.Atoi(sprintIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sprint_id: %v", err)
+		}
+		sprints, err := listBoardSprints(ctx, boardID, []string{"active", "closed"})
+		if err != nil {
+			return nil, err
+		}
+		for _, candidate := range sprints {
+			if candidate.ID == sprintID {
+				sprint = candidate
+			}
+		}
+		if sprint == nil {
+			return nil, fmt.Errorf("sprint %d is not an active or closed sprint of board %d", sprintID, boardID)
+		}
+	} else {
+		active, err := listBoardSprints(ctx, boardID, []string{"active"})
+		if err != nil {
+			return nil, err
+		}
+		if len(active) == 0 {
+			return nil, fmt.Errorf("board %d has no active sprint, pass a sprint_id to chart a closed sprint", boardID)
+		}
+		sprint = active[0]
+	}
+
+	config, err := getBoardConfiguration(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	sprintField, err := findFieldID(ctx, "Sprint")
+	if err != nil {
+		return nil, err
+	}
+
+	// Like Jira's own burndown, time tracking boards burn down the remaining estimate.
+	estimationField := boardEstimationField(config)
+	estimationName := boardEstimationFieldName(config)
+	if isTimeEstimate(estimationField) {
+		estimationField, estimationName = "timeestimate", "timeestimate"
+	}
+
+	scope, warning, err := getSprintScope(ctx, config, estimationField, sprint.ID, sprint.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	_, columnOf, doneColumn := boardColumns(config)
+	timelines := make([]*burndownTimeline, 0, len(scope))
+	for _, issue := range scope {
+		if issue.Issue.Fields.IssueType != nil && issue.Issue.Fields.IssueType.Subtask {
+			continue
+		}
+		timelines = append(timelines, newBurndownTimeline(issue, sprintField, estimationField, estimationName, sprint.ID))
+	}
+
+	// remainingAt sums the open estimate of issues in the sprint at a moment;
+	// boards without estimates burn down issue counts.
+	remainingAt := func(at time.Time) float64 {
+		var remaining float64
+		for _, timeline := range timelines {
+			if !timeline.inSprintAt(at) {
+				continue
+			}
+			if column, ok := columnOf[timeline.statusAt(at)]; ok && column == doneColumn {
+				continue
+			}
+			if estimationField == "" {
+				remaining++
+			} else {
+				remaining += timeline.estimateAt(at)
+			}
+		}
+		return estimateInUnit(estimationField, remaining)
+	}
+
+	start := sprint.StartDate
+	end := sprint.EndDate
+	if sprint.State == "closed" && !sprint.CompleteDate.IsZero() {
+		end = sprint.CompleteDate
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("sprint %d has no valid date range", sprint.ID)
+	}
+
+	unit := estimateUnit(estimationField)
+	if estimationField == "" {
+		unit = "issues"
+	}
+
+	burndown := &sprintBurndown{SprintID: sprint.ID, Sprint: sprint.Name, Unit: unit, Start: start, End: end}
+	if warning != "" {
+		burndown.Warnings = append(burndown.Warnings, warning)
+	}
+
+	committed := remainingAt(start)
+	burndown.Days = append(burndown.Days, &burndownDay{Date: "start", Remaining: roundTo(committed, 1), Ideal: roundTo(committed, 1)})
+
+	totalDuration := end.Sub(start)
+	now := time.Now()
+	dayStart := start
+	for dayStart.Before(end) && dayStart.Before(now) {
+		y, m, d := dayStart.Date()
+		dayEnd := time.Date(y, m, d+1, 0, 0, 0, 0, dayStart.Location())
+		if dayEnd.After(end) {
+			dayEnd = end
+		}
+
+		point := dayEnd
+		if point.After(now) {
+			point = now
+		}
+
+		ideal := committed * (1 - float64(dayEnd.Sub(start))/float64(totalDuration))
+		day := &burndownDay{
+			Date:      dayStart.Format("2006-01-02"),
+			Remaining: roundTo(remainingAt(point), 1),
+			Ideal:     roundTo(max(ideal, 0), 1),
+		}
+		for _, timeline := range timelines {
+			day.Changes = append(day.Changes, timeline.scopeChanges(dayStart, point, estimationField)...)
+		}
+		day.ScopeChanged = len(day.Changes) > 0
+
+		burndown.Days = append(burndown.Days, day)
+		dayStart = dayEnd
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Burndown: %s (ID: %d)\nBoard: %s (ID: %d)\nPeriod: %s to %s\nUnit: %s\n\n",
+		sprint.Name, sprint.ID, config.Name, config.ID, start.Format("2006-01-02 15:04"), end.Format("2006-01-02 15:04"), unit))
+	sb.WriteString("| Date | Remaining | Ideal | Scope change |\n")
+	sb.WriteString("|---|---|---|---|\n")
+	for _, day := range burndown.Days {
+		change := ""
+		if day.ScopeChanged {
+			change = "yes: " + strings.Join(day.Changes, ", ")
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %g | %g | %s |\n", day.Date, day.Remaining, day.Ideal, change))
+	}
+
+	for _, warning := range burndown.Warnings {
+		sb.WriteString(fmt.Sprintf("Warning: %s\n", warning))
+	}
+
+	if includeJSON {
+		data, err := toJSON(burndown)
+		if err != nil {
+			return nil, err
+		}
+		sb.WriteString("\n```json\n" + data + "\n```\n")
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// burndownTimeline answers point-in-time questions about one sprint issue by
+// replaying its changelog.
+type burndownTimeline struct {
+	key             string
+	created         time.Time
+	sprintID        int
+	sprints         fieldHistory
+	currentSprint   string
+	statuses        fieldHistory
+	currentStatus   string
+	estimates       fieldHistory
+	currentEstimate string
+	// committedEstimates are the changes that count as scope changes. On time
+	// tracking boards the remaining estimate also drops as work is logged,
+	// which is burn, so only original estimate changes count.
+	committedEstimates fieldHistory
+}
+
+func newBurndownTimeline(issue *sprintScopeIssue, sprintField, estimationField, estimationName string, sprintID int) *burndownTimeline {
+	timeline := &burndownTimeline{
+		key:      issue.Issue.Key,
+		created:  parseJiraTime(issue.Issue.Fields.Created),
+		sprintID: sprintID,
+		sprints:  changelogHistory(issue.Issue, sprintField, "Sprint"),
+		statuses: changelogHistory(issue.Issue, "status", "status"),
+	}
+	if issue.currentlyInSprint {
+		timeline.currentSprint = strconv.Itoa(sprintID)
+	}
+	if issue.Issue.Fields.Status != nil {
+		timeline.currentStatus = issue.Issue.Fields.Status.ID
+	}
+	if estimationField != "" {
+		timeline.estimates = changelogHistory(issue.Issue, estimationField, estimationName)
+		if issue.Estimated {
+			timeline.currentEstimate = strconv.FormatFloat(issue.Estimate, 'f', -1, 64)
+		}
+		timeline.committedEstimates = timeline.estimates
+		if estimationField == "timeestimate" {
+			timeline.committedEstimates = changelogHistory(issue.Issue, "timeoriginalestimate", "timeoriginalestimate")
+		}
+	}
+	return timeline
+}
+
+func (t *burndownTimeline) inSprintAt(at time.Time) bool {
+	if !t.created.IsZero() && at.Before(t.created) {
+		return false
+	}
+	value, _ := t.sprints.valueAt(at, t.currentSprint, "")
+	return containsSprint(value, t.sprintID)
+}
+
+func (t *burndownTimeline) statusAt(at time.Time) string {
+	status, _ := t.statuses.valueAt(at, t.currentStatus, "")
+	return status
+}
+
+func (t *burndownTimeline) estimateAt(at time.Time) float64 {
+	return parseEstimate(t.estimates.valueAt(at, t.currentEstimate, t.currentEstimate))
+}
+
+// scopeChanges describes sprint membership and committed estimate changes of
+// the issue in (from, to]. Estimate changes only count while the issue is in
+// the sprint.
+func (t *burndownTimeline) scopeChanges(from, to time.Time, estimationField string) []string {
+	var changes []string
+	for _, change := range t.sprints.between(from, to) {
+		wasIn, isIn := containsSprint(change.From, t.sprintID), containsSprint(change.To, t.sprintID)
+		if !wasIn && isIn {
+			changes = append(changes, "+"+t.key)
+		} else if wasIn && !isIn {
+			changes = append(changes, "-"+t.key)
+		}
+	}
+	for _, change := range t.committedEstimates.between(from, to) {
+		if !t.inSprintAt(change.At) {
+			continue
+		}
+		before := estimateInUnit(estimationField, parseEstimate(change.From, change.FromString))
+		after := estimateInUnit(estimationField, parseEstimate(change.To, change.ToString))
+		changes = append(changes, fmt.Sprintf("%s %g→%g", t.key, roundTo(before, 1), roundTo(after, 1)))
+	}
+	return changes
+}
+
+// sprintScopeIssue is an issue that was part of a sprint at some point, and
+// whether it is still in the sprint now.
+type sprintScopeIssue struct {
+	*estimatedIssue
+	currentlyInSprint bool
+}
+
+// getSprintScope loads every issue that belonged to a sprint at any time, with
+// its changelog. Issues still in the sprint come from the sprint clause; issues
+// taken out mid-sprint are only reachable through removedAfterSprintStart, and
+// a warning is returned when that function is unavailable.
+func getSprintScope(ctx context.Context, config *models.BoardConfigurationScheme, estimationField string, sprintID int, sprintName string) ([]*sprintScopeIssue, string, error) {
+	fields := []string{"summary", "status", "issuetype", "created", "resolutiondate"}
+	if estimationField != "" {
+		fields = append(fields, estimationField)
+	}
+
+	current, err := searchIssuesWithChangelog(ctx, fmt.Sprintf("sprint = %d", sprintID), fields, estimationField)
+	if err != nil {
+		return nil, "", err
+	}
+
+	scope := make([]*sprintScopeIssue, 0, len(current))
+	seen := map[string]bool{}
+	for _, issue := range current {
+		scope = append(scope, &sprintScopeIssue{estimatedIssue: issue, currentlyInSprint: true})
+		seen[issue.Issue.Key] = true
+	}
+
+	removedJQL := fmt.Sprintf("issue in removedAfterSprintStart(%s, %s)", quoteJQL(config.Name), quoteJQL(sprintName))
+	removed, err := searchIssuesWithChangelog(ctx, removedJQL, fields, estimationField)
+	if err != nil {
+		return scope, fmt.Sprintf("issues removed from %s could not be listed, so removed scope may be understated", sprintName), nil
+	}
+
+	for _, issue := range removed {
+		if !seen[issue.Issue.Key] {
+			scope = append(scope, &sprintScopeIssue{estimatedIssue: issue})
+		}
+	}
+
+	return scope, "", nil
+}
+
+// sprintOutcome is what happened to one issue during a sprint.
+type sprintOutcome struct {
+	inAtStart     bool
+	inAtEnd       bool
+	added         bool
+	removed       bool
+	completed     bool
+	startEstimate float64
+	endEstimate   float64
+}
+
+// replaySprintIssue replays an issue's Sprint, status and estimate changes to
+// find its sprint membership and estimate at the start and end of a sprint.
+func replaySprintIssue(issue *sprintScopeIssue, config *models.BoardConfigurationScheme, sprintField string, sprintID int, start, end time.Time) sprintOutcome {
+	fields := issue.Issue.Fields
+	if fields.IssueType != nil && fields.IssueType.Subtask {
+		return sprintOutcome{}
+	}
+
+	created := parseJiraTime(fields.Created)
+	sprints := changelogHistory(issue.Issue, sprintField, "Sprint")
+
+	current := ""
+	if issue.currentlyInSprint {
+		current = strconv.Itoa(sprintID)
+	}
+
+	inAt := func(at time.Time) bool {
+		if !created.IsZero() && at.Before(created) {
+			return false
+		}
+		value, _ := sprints.valueAt(at, current, "")
+		return containsSprint(value, sprintID)
+	}
+
+	var outcome sprintOutcome
+	outcome.inAtStart = inAt(start)
+	outcome.inAtEnd = inAt(end)
+
+	everIn := outcome.inAtStart || outcome.inAtEnd
+	for _, change := range sprints.between(start, end) {
+		if containsSprint(change.From, sprintID) || containsSprint(change.To, sprintID) {
+			everIn = true
+		}
+	}
+	if !created.IsZero() && created.After(start) && !created.After(end) && len(sprints) == 0 && issue.currentlyInSprint {
+		everIn = true
+	}
+
+	outcome.added = everIn && !outcome.inAtStart
+	outcome.removed = everIn && !outcome.inAtEnd
+
+	estimationField := boardEstimationField(config)
+	if estimationField != "" {
+		estimates := changelogHistory(issue.Issue, estimationField, boardEstimationFieldName(config))
+		currentEstimate := ""
+		if issue.Estimated {
+			currentEstimate = strconv.FormatFloat(issue.Estimate, 'f', -1, 64)
+		}
+		outcome.startEstimate = parseEstimate(estimates.valueAt(start, currentEstimate, currentEstimate))
+		outcome.endEstimate = parseEstimate(estimates.valueAt(end, currentEstimate, currentEstimate))
+	}
+
+	if outcome.inAtEnd && fields.Status != nil {
+		_, columnOf, doneColumn := boardColumns(config)
+		status, _ := changelogHistory(issue.Issue, "status", "status").valueAt(end, fields.Status.ID, fields.Status.Name)
+		if column, ok := columnOf[status]; ok && column == doneColumn {
+			outcome.completed = true
+		}
+	}
+
+	return outcome
+}
+
+// boardEstimationFieldName is the display name of the board's estimation
+// field, used to match changelog entries that carry no field ID.
+func boardEstimationFieldName(config *models.BoardConfigurationScheme) string {
+	if config.Estimation == nil || config.Estimation.Field == nil {
+		return ""
+	}
+	return config.Estimation.Field.DisplayName
+}
+
+// roundTo rounds a value to the given number of decimals for display.
+func roundTo(value float64, decimals int) float64 {
+	factor := math.Pow(10, float64(decimals))
+	return math.Round(value*factor) / factor
+}
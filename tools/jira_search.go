@@ -3,6 +3,8 @@ package tools
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"regexp"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -20,27 +22,44 @@ func RegisterJiraSearchTool(s *server.MCPServer) {
 }
 
 func jiraSearchHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	client := services.JiraClient()
-
 	jql, ok := request.Params.Arguments["jql"].(string)
 	if !ok {
 		return nil, fmt.Errorf("jql argument is required")
 	}
-	
+
+	result, err := renderJQLSearch(ctx, jql)
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+// renderJQLSearch runs a JQL query and renders the matching issues the way
+// jira_search_issue shows them.
+func renderJQLSearch(ctx context.Context, jql string) (string, error) {
+	client := services.JiraClient()
+
 	searchResult, response, err := client.Issue.Search.Get(ctx, jql, nil, nil, 0, 30, "")
 	if err != nil {
 		if response != nil {
-			return nil, fmt.Errorf("failed to search issues: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			if response.Code == http.StatusBadRequest {
+				return "", fmt.Errorf("failed to search issues: %s (endpoint: %s), use jira_validate_jql to locate the problem in the query", response.Bytes.String(), response.Endpoint)
+			}
+			return "", fmt.Errorf("failed to search issues: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
 		}
-		return nil, fmt.Errorf("failed to search issues: %v", err)
+		return "", fmt.Errorf("failed to search issues: %v", err)
 	}
 
 	if len(searchResult.Issues) == 0 {
-		return mcp.NewToolResultText("No issues found matching the search criteria."), nil
+		return "No issues found matching the search criteria.", nil
 	}
 
 	var sb strings.Builder
+	categoryCounts := map[string]int{}
 	for _, issue := range searchResult.Issues {
+		categoryCounts[issueStatusCategory(issue)]++
+
 		sb.WriteString(fmt.Sprintf("Key: %s\n", issue.Key))
 
 		if issue.Fields.Summary != "" {
@@ -48,7 +67,7 @@ func jiraSearchHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.C
 		}
 
 		if issue.Fields.Status != nil && issue.Fields.Status.Name != "" {
-			sb.WriteString(fmt.Sprintf("Status: %s\n", issue.Fields.Status.Name))
+			sb.WriteString(fmt.Sprintf("Status: %s (%s)\n", issue.Fields.Status.Name, statusCategoryName(issueStatusCategory(issue))))
 		}
 
 		if issue.Fields.Created != "" {
@@ -78,5 +97,66 @@ func jiraSearchHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.C
 		sb.WriteString("\n")
 	}
 
-	return mcp.NewToolResultText(sb.String()), nil
+	var categories []string
+	for _, category := range statusCategoryOrder {
+		if categoryCounts[category] > 0 {
+			categories = append(categories, fmt.Sprintf("%s: %d", statusCategoryNames[category], categoryCounts[category]))
+		}
+	}
+	// The counts only cover the issues fetched, so say so when there are more.
+	if searchResult.Total > len(searchResult.Issues) {
+		sb.WriteString(fmt.Sprintf("By status category (first %d of %d issues): %s\n", len(searchResult.Issues), searchResult.Total, strings.Join(categories, ", ")))
+	} else {
+		sb.WriteString(fmt.Sprintf("By status category: %s\n", strings.Join(categories, ", ")))
+	}
+
+	return sb.String(), nil
+}
+
+// quoteJQL quotes a value for use in a JQL string literal, escaping quotes and
+// backslashes so user input cannot change the structure of the query.
+func quoteJQL(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
+
+// orderByPattern matches the ORDER BY clause that ends a JQL query.
+var orderByPattern = regexp.MustCompile(`(?i)(^|\s+)order\s+by\s+`)
+
+// maskJQLStrings blanks the contents of quoted JQL strings, keeping byte
+// offsets, so keywords inside values such as "sort order by date" are not
+// mistaken for clauses.
+func maskJQLStrings(jql string) string {
+	masked := []byte(jql)
+	var quote byte
+	for i := 0; i < len(masked); i++ {
+		switch c := masked[i]; {
+		case quote == 0:
+			if c == '"' || c == '\'' {
+				quote = c
+			}
+		case c == '\\' && i+1 < len(masked):
+			masked[i], masked[i+1] = ' ', ' '
+			i++
+		case c == quote:
+			quote = 0
+		default:
+			masked[i] = ' '
+		}
+	}
+	return string(masked)
+}
+
+// andJQL narrows a JQL query with an extra clause, keeping the query's
+// ORDER BY clause at the end where JQL requires it.
+func andJQL(jql, clause string) string {
+	orderBy := ""
+	if location := orderByPattern.FindStringIndex(maskJQLStrings(jql)); location != nil {
+		jql, orderBy = jql[:location[0]], " ORDER BY "+jql[location[1]:]
+	}
+	if strings.TrimSpace(jql) == "" {
+		return clause + orderBy
+	}
+	return fmt.Sprintf("(%s) AND %s%s", jql, clause, orderBy)
 }
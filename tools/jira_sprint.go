@@ -2,9 +2,14 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/nguyenvanduocit/jira-mcp/services"
@@ -13,10 +18,63 @@ import (
 
 func RegisterJiraSprintTool(s *server.MCPServer) {
 	jiraListSprintTool := mcp.NewTool("jira_list_sprints",
-		mcp.WithDescription("List all active and future sprints for a specific Jira board, including sprint IDs, names, states, and dates"),
-		mcp.WithString("board_id", mcp.Required(), mcp.Description("Numeric ID of the Jira board (can be found in board URL)")),
+		mcp.WithDescription("List sprints for a specific Jira board, including sprint IDs, names, states, and dates. Returns active and future sprints unless a state is given"),
+		mcp.WithString("board_id", mcp.Required(), mcp.Description("Numeric ID of the Jira board (can be found in board URL or with jira_list_boards)")),
+		mcp.WithString("state", mcp.Description("Comma-separated sprint states to include: active, future, closed (default: active,future)")),
+		mcp.WithString("name", mcp.Description("Only sprints whose name contains this text, case-insensitive")),
 	)
 	s.AddTool(jiraListSprintTool, util.ErrorGuard(jiraListSprintHandler))
+
+	jiraGetSprintIssuesTool := mcp.NewTool("jira_get_sprint_issues",
+		mcp.WithDescription("List the issues in a sprint grouped by board column, with assignee, story points and flagged state, plus committed vs. completed totals"),
+		mcp.WithString("sprint_id", mcp.Required(), mcp.Description("Numeric ID of the sprint, from jira_list_sprints")),
+		mcp.WithString("board_id", mcp.Description("Board whose columns and estimation field are used (default: the board the sprint was created on)")),
+	)
+	s.AddTool(jiraGetSprintIssuesTool, util.ErrorGuard(jiraGetSprintIssuesHandler))
+
+	jiraCreateSprintTool := mcp.NewTool("jira_create_sprint",
+		mcp.WithDescription("Create a new future sprint on a scrum board"),
+		mcp.WithString("board_id", mcp.Required(), mcp.Description("Numeric ID of the board the sprint belongs to")),
+		mcp.WithString("name", mcp.Required(), mcp.Description("Name of the sprint (e.g., Sprint 42)")),
+		mcp.WithString("goal", mcp.Description("Sprint goal (optional)")),
+		mcp.WithString("start_date", mcp.Description("Planned start date, YYYY-MM-DD or ISO 8601 (optional)")),
+		mcp.WithString("end_date", mcp.Description("Planned end date, YYYY-MM-DD or ISO 8601 (optional)")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraCreateSprintTool, util.ErrorGuard(jiraCreateSprintHandler))
+	}
+
+	jiraUpdateSprintTool := mcp.NewTool("jira_update_sprint",
+		mcp.WithDescription("Update a sprint's name, goal or dates. Only specified fields will be changed"),
+		mcp.WithString("sprint_id", mcp.Required(), mcp.Description("Numeric ID of the sprint")),
+		mcp.WithString("name", mcp.Description("New name (optional)")),
+		mcp.WithString("goal", mcp.Description("New sprint goal, empty string to clear it (optional)")),
+		mcp.WithString("start_date", mcp.Description("New start date, YYYY-MM-DD or ISO 8601 (optional)")),
+		mcp.WithString("end_date", mcp.Description("New end date, YYYY-MM-DD or ISO 8601 (optional)")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraUpdateSprintTool, util.ErrorGuard(jiraUpdateSprintHandler))
+	}
+
+	jiraStartSprintTool := mcp.NewTool("jira_start_sprint",
+		mcp.WithDescription("Start a future sprint, making it the board's active sprint"),
+		mcp.WithString("sprint_id", mcp.Required(), mcp.Description("Numeric ID of the future sprint to start")),
+		mcp.WithString("start_date", mcp.Description("Start date, YYYY-MM-DD or ISO 8601 (default: now)")),
+		mcp.WithString("end_date", mcp.Description("End date, YYYY-MM-DD or ISO 8601 (default: the sprint's planned end date, or two weeks after the start)")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraStartSprintTool, util.ErrorGuard(jiraStartSprintHandler))
+	}
+
+	jiraCloseSprintTool := mcp.NewTool("jira_close_sprint",
+		mcp.WithDescription("Complete an active sprint. Issues not in the board's done column are moved to the next sprint, the backlog, or a given sprint first"),
+		mcp.WithString("sprint_id", mcp.Required(), mcp.Description("Numeric ID of the active sprint to close")),
+		mcp.WithString("move_incomplete_to", mcp.Required(), mcp.Description("Where incomplete issues go: \"next\" (the board's next future sprint), \"backlog\", or a sprint ID")),
+		mcp.WithString("board_id", mcp.Description("Board whose done column and future sprints are used (default: the board the sprint was created on)")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraCloseSprintTool, util.ErrorGuard(jiraCloseSprintHandler))
+	}
 }
 
 func jiraListSprintHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -30,22 +88,550 @@ func jiraListSprintHandler(ctx context.Context, request mcp.CallToolRequest) (*m
 		return nil, fmt.Errorf("invalid board_id: %v", err)
 	}
 
-	sprints, response, err := services.AgileClient().Board.Sprints(ctx, boardID, 0, 50, []string{"active", "future"})
+	states := []string{"active", "future"}
+	if stateArg, ok := request.Params.Arguments["state"].(string); ok && stateArg != "" {
+		states = nil
+		for _, state := range splitList(strings.ToLower(stateArg)) {
+			if state != "active" && state != "future" && state != "closed" {
+				return nil, fmt.Errorf("invalid state %q: must be active, future or closed", state)
+			}
+			states = append(states, state)
+		}
+	}
+
+	nameFilter, _ := request.Params.Arguments["name"].(string)
+	nameFilter = strings.ToLower(strings.TrimSpace(nameFilter))
+
+	sprints, err := listBoardSprints(ctx, boardID, states)
 	if err != nil {
-		if response != nil {
-			return nil, fmt.Errorf("failed to get sprints: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		return nil, err
+	}
+
+	var result string
+	for _, sprint := range sprints {
+		if nameFilter != "" && !strings.Contains(strings.ToLower(sprint.Name), nameFilter) {
+			continue
 		}
-		return nil, fmt.Errorf("failed to get sprints: %v", err)
+		result += fmt.Sprintf("ID: %d\nName: %s\nState: %s\nStartDate: %s\nEndDate: %s\n", sprint.ID, sprint.Name, sprint.State, sprint.StartDate, sprint.EndDate)
+		if sprint.State == "closed" {
+			result += fmt.Sprintf("CompleteDate: %s\n", sprint.CompleteDate)
+		}
+		if sprint.Goal != "" {
+			result += fmt.Sprintf("Goal: %s\n", sprint.Goal)
+		}
+		result += "\n"
 	}
 
-	if len(sprints.Values) == 0 {
+	if result == "" {
 		return mcp.NewToolResultText("No sprints found for this board."), nil
 	}
 
-	var result string
-	for _, sprint := range sprints.Values {
-		result += fmt.Sprintf("ID: %d\nName: %s\nState: %s\nStartDate: %s\nEndDate: %s\n\n", sprint.ID, sprint.Name, sprint.State, sprint.StartDate, sprint.EndDate)
+	return mcp.NewToolResultText(result), nil
+}
+
+// listBoardSprints pages through every sprint of a board in the given states,
+// oldest first as returned by Jira.
+func listBoardSprints(ctx context.Context, boardID int, states []string) ([]*models.BoardSprintScheme, error) {
+	var sprints []*models.BoardSprintScheme
+	for startAt := 0; ; {
+		page, response, err := services.AgileClient().Board.Sprints(ctx, boardID, startAt, 50, states)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get sprints: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get sprints: %v", err)
+		}
+
+		sprints = append(sprints, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return sprints, nil
+		}
+		startAt += len(page.Values)
+	}
+}
+
+func jiraGetSprintIssuesHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	sprintIDStr, ok := request.Params.Arguments["sprint_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("sprint_id argument is required")
+	}
+
+	sprintID, err := strconv.Atoi(sprintIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sprint_id: %v", err)
+	}
+
+	sprint, response, err := services.AgileClient().Sprint.Get(ctx, sprintID)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get sprint: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get sprint: %v", err)
+	}
+
+	boardID, err := sprintBoardID(request, sprint)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := getBoardConfiguration(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	flaggedField, err := findFieldID(ctx, "Flagged")
+	if err != nil {
+		return nil, err
+	}
+
+	estimationField := boardEstimationField(config)
+	issues, err := getSprintIssues(ctx, sprintID, "", estimationField, flaggedField)
+	if err != nil {
+		return nil, err
+	}
+
+	columnNames, columnOf, doneColumn := boardColumns(config)
+
+	// Issues whose status is not on the board go into a trailing "Unmapped" group.
+	groups := make([][]*estimatedIssue, len(columnNames)+1)
+	for _, issue := range issues {
+		column := len(columnNames)
+		if issue.Issue.Fields.Status != nil {
+			if index, ok := columnOf[issue.Issue.Fields.Status.ID]; ok {
+				column = index
+			}
+		}
+		groups[column] = append(groups[column], issue)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Sprint: %s (ID: %d)\nState: %s\nStartDate: %s\nEndDate: %s\n", sprint.Name, sprint.ID, sprint.State, sprint.StartDate, sprint.EndDate))
+	if sprint.Goal != "" {
+		sb.WriteString(fmt.Sprintf("Goal: %s\n", sprint.Goal))
+	}
+	sb.WriteString(fmt.Sprintf("Board: %s (ID: %d)\nEstimation: %s\n", config.Name, config.ID, boardEstimationName(config)))
+
+	var committed, completed float64
+	var completedCount, flaggedCount, unestimatedCount int
+	for index, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		name := "Unmapped"
+		if index < len(columnNames) {
+			name = columnNames[index]
+		}
+
+		var columnTotal float64
+		for _, issue := range group {
+			columnTotal += issue.Estimate
+		}
+
+		sb.WriteString(fmt.Sprintf("\n== %s (%d issues", name, len(group)))
+		if estimationField != "" {
+			sb.WriteString(", " + formatEstimate(estimationField, columnTotal))
+		}
+		sb.WriteString(") ==\n")
+
+		for _, issue := range group {
+			sb.WriteString(formatSprintIssue(issue, estimationField) + "\n")
+
+			committed += issue.Estimate
+			if !issue.Estimated {
+				unestimatedCount++
+			}
+			if issue.Flagged {
+				flaggedCount++
+			}
+			if index == doneColumn {
+				completed += issue.Estimate
+				completedCount++
+			}
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("\nTotals:\nIssues: %d committed, %d completed\n", len(issues), completedCount))
+	if estimationField != "" {
+		sb.WriteString(fmt.Sprintf("Estimate: %s committed, %s completed, %s remaining\n",
+			formatEstimate(estimationField, committed),
+			formatEstimate(estimationField, completed),
+			formatEstimate(estimationField, committed-completed)))
+		sb.WriteString(fmt.Sprintf("Unestimated: %d\n", unestimatedCount))
+	}
+	sb.WriteString(fmt.Sprintf("Flagged: %d\n", flaggedCount))
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// formatSprintIssue renders one line of a sprint or backlog listing.
+func formatSprintIssue(issue *estimatedIssue, estimationField string) string {
+	fields := issue.Issue.Fields
+
+	issueType := ""
+	if fields.IssueType != nil {
+		issueType = fmt.Sprintf("[%s] ", fields.IssueType.Name)
+	}
+
+	status := "Unknown"
+	if fields.Status != nil {
+		status = fields.Status.Name
+	}
+
+	assignee := "Unassigned"
+	if fields.Assignee != nil {
+		assignee = fields.Assignee.DisplayName
+	}
+
+	line := fmt.Sprintf("- %s %s%s | %s | %s", issue.Issue.Key, issueType, fields.Summary, status, assignee)
+	if estimationField != "" {
+		if issue.Estimated {
+			line += " | " + formatEstimate(estimationField, issue.Estimate)
+		} else {
+			line += " | unestimated"
+		}
+	}
+	if issue.Flagged {
+		line += " | FLAGGED"
+	}
+	return line
+}
+
+func jiraCreateSprintHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	boardIDStr, ok := request.Params.Arguments["board_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("board_id argument is required")
+	}
+
+	boardID, err := strconv.Atoi(boardIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid board_id: %v", err)
+	}
+
+	name, ok := request.Params.Arguments["name"].(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("name argument is required")
+	}
+
+	payload := &models.SprintPayloadScheme{
+		Name:          name,
+		OriginBoardID: boardID,
+	}
+	if err := applySprintArguments(payload, request.Params.Arguments); err != nil {
+		return nil, err
+	}
+
+	sprint, response, err := services.AgileClient().Sprint.Create(ctx, payload)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to create sprint: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to create sprint: %v", err)
+	}
+
+	return mcp.NewToolResultText("Sprint created successfully!\n" + formatSprint(sprint)), nil
+}
+
+func jiraUpdateSprintHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	sprintIDStr, ok := request.Params.Arguments["sprint_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("sprint_id argument is required")
+	}
+
+	sprintID, err := strconv.Atoi(sprintIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sprint_id: %v", err)
+	}
+
+	payload := &models.SprintPayloadScheme{}
+	if name, ok := request.Params.Arguments["name"].(string); ok {
+		payload.Name = name
+	}
+	if err := applySprintArguments(payload, request.Params.Arguments); err != nil {
+		return nil, err
+	}
+
+	// The update is partial, so fields left empty keep their current value.
+	// An empty goal is dropped by the payload's omitempty tag, so clearing the
+	// goal sends it explicitly.
+	var body interface{} = payload
+	if goal, ok := request.Params.Arguments["goal"].(string); ok && goal == "" {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode sprint: %v", err)
+		}
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, fmt.Errorf("failed to encode sprint: %v", err)
+		}
+		fields["goal"] = ""
+		body = fields
+	}
+
+	client := services.AgileClient()
+	updateRequest, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID), "", body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sprint update request: %v", err)
+	}
+
+	sprint := new(models.SprintScheme)
+	response, err := client.Call(updateRequest, sprint)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to update sprint: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to update sprint: %v", err)
+	}
+
+	return mcp.NewToolResultText("Sprint updated successfully!\n" + formatSprint(sprint)), nil
+}
+
+func jiraStartSprintHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	sprintIDStr, ok := request.Params.Arguments["sprint_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("sprint_id argument is required")
+	}
+
+	sprintID, err := strconv.Atoi(sprintIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sprint_id: %v", err)
+	}
+
+	sprint, response, err := services.AgileClient().Sprint.Get(ctx, sprintID)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get sprint: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get sprint: %v", err)
+	}
+
+	if sprint.State != "future" {
+		return nil, fmt.Errorf("sprint %d is %s, only future sprints can be started", sprintID, sprint.State)
+	}
+
+	// Jira refuses to start a sprint without dates, so fill in sensible defaults.
+	start := time.Now()
+	if startArg, ok := request.Params.Arguments["start_date"].(string); ok && startArg != "" {
+		if start, err = parseSprintDate(startArg); err != nil {
+			return nil, err
+		}
+	}
+
+	end := start.AddDate(0, 0, 14)
+	if !sprint.EndDate.IsZero() && sprint.EndDate.After(start) {
+		end = sprint.EndDate
+	}
+	if endArg, ok := request.Params.Arguments["end_date"].(string); ok && endArg != "" {
+		if end, err = parseSprintDate(endArg); err != nil {
+			return nil, err
+		}
+	}
+
+	if !end.After(start) {
+		return nil, fmt.Errorf("end_date must be after start_date")
+	}
+
+	payload := &models.SprintPayloadScheme{
+		State:     "active",
+		StartDate: start.Format(sprintDateLayout),
+		EndDate:   end.Format(sprintDateLayout),
+	}
+
+	started, response, err := services.AgileClient().Sprint.Path(ctx, sprintID, payload)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to start sprint: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to start sprint: %v", err)
+	}
+
+	return mcp.NewToolResultText("Sprint started successfully!\n" + formatSprint(started)), nil
+}
+
+func jiraCloseSprintHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.AgileClient()
+
+	sprintIDStr, ok := request.Params.Arguments["sprint_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("sprint_id argument is required")
+	}
+
+	sprintID, err := strconv.Atoi(sprintIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sprint_id: %v", err)
+	}
+
+	destination, ok := request.Params.Arguments["move_incomplete_to"].(string)
+	if !ok || destination == "" {
+		return nil, fmt.Errorf("move_incomplete_to argument is required")
+	}
+
+	sprint, response, err := client.Sprint.Get(ctx, sprintID)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get sprint: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get sprint: %v", err)
+	}
+
+	if sprint.State != "active" {
+		return nil, fmt.Errorf("sprint %d is %s, only active sprints can be closed", sprintID, sprint.State)
+	}
+
+	boardID, err := sprintBoardID(request, sprint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the destination before touching any issue so a bad argument changes nothing.
+	targetSprintID := 0
+	targetName := "the backlog"
+	switch strings.ToLower(strings.TrimSpace(destination)) {
+	case "backlog":
+	case "next":
+		future, err := listBoardSprints(ctx, boardID, []string{"future"})
+		if err != nil {
+			return nil, err
+		}
+		if len(future) == 0 {
+			return nil, fmt.Errorf("board %d has no future sprint to move incomplete issues to, create one first or use \"backlog\"", boardID)
+		}
+		targetSprintID = future[0].ID
+		targetName = fmt.Sprintf("%s (ID: %d)", future[0].Name, future[0].ID)
+	default:
+		targetSprintID, err = strconv.Atoi(destination)
+		if err != nil {
+			return nil, fmt.Errorf("invalid move_incomplete_to: must be \"next\", \"backlog\" or a sprint ID")
+		}
+		target, response, err := client.Sprint.Get(ctx, targetSprintID)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get target sprint: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get target sprint: %v", err)
+		}
+		if target.State == "closed" {
+			return nil, fmt.Errorf("target sprint %d is already closed", targetSprintID)
+		}
+		targetName = fmt.Sprintf("%s (ID: %d)", target.Name, target.ID)
+	}
+
+	config, err := getBoardConfiguration(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	_, columnOf, doneColumn := boardColumns(config)
+
+	issues, err := getSprintIssues(ctx, sprintID, "", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Subtasks follow their parent, so only standard issues are moved explicitly.
+	var incomplete []string
+	completed := 0
+	for _, issue := range issues {
+		fields := issue.Issue.Fields
+		if fields.Status != nil {
+			if column, ok := columnOf[fields.Status.ID]; ok && column == doneColumn {
+				completed++
+				continue
+			}
+		}
+		if fields.IssueType != nil && fields.IssueType.Subtask {
+			continue
+		}
+		incomplete = append(incomplete, issue.Issue.Key)
+	}
+
+	for start := 0; start < len(incomplete); start += 50 {
+		batch := incomplete[start:min(start+50, len(incomplete))]
+
+		var response *models.ResponseScheme
+		if targetSprintID == 0 {
+			response, err = client.Backlog.Move(ctx, batch)
+		} else {
+			response, err = client.Sprint.Move(ctx, targetSprintID, &models.SprintMovePayloadScheme{Issues: batch})
+		}
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to move incomplete issues: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to move incomplete issues: %v", err)
+		}
+	}
+
+	response, err = client.Sprint.Close(ctx, sprintID)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to close sprint: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to close sprint: %v", err)
+	}
+
+	result := fmt.Sprintf("Sprint %s (ID: %d) closed successfully!\nCompleted issues: %d\nIncomplete issues moved to %s: %d\n",
+		sprint.Name, sprint.ID, completed, targetName, len(incomplete))
+	if len(incomplete) > 0 {
+		result += "Moved: " + strings.Join(incomplete, ", ") + "\n"
 	}
 
 	return mcp.NewToolResultText(result), nil
 }
+
+// sprintDateLayout is the timestamp format the agile API expects for sprint dates.
+const sprintDateLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// parseSprintDate accepts a plain date or a full ISO 8601 timestamp.
+func parseSprintDate(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, sprintDateLayout, "2006-01-02T15:04:05.000-0700", "2006-01-02"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q: use YYYY-MM-DD or ISO 8601", value)
+}
+
+// applySprintArguments copies the optional goal and date arguments onto a
+// sprint payload.
+func applySprintArguments(payload *models.SprintPayloadScheme, arguments map[string]interface{}) error {
+	if goal, ok := arguments["goal"].(string); ok {
+		payload.Goal = goal
+	}
+	for name, target := range map[string]*string{"start_date": &payload.StartDate, "end_date": &payload.EndDate} {
+		value, ok := arguments[name].(string)
+		if !ok || value == "" {
+			continue
+		}
+		date, err := parseSprintDate(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", name, err)
+		}
+		*target = date.Format(sprintDateLayout)
+	}
+	return nil
+}
+
+func formatSprint(sprint *models.SprintScheme) string {
+	result := fmt.Sprintf("ID: %d\nName: %s\nState: %s\nStartDate: %s\nEndDate: %s\nBoard ID: %d\n",
+		sprint.ID, sprint.Name, sprint.State, sprint.StartDate, sprint.EndDate, sprint.OriginBoardID)
+	if sprint.Goal != "" {
+		result += fmt.Sprintf("Goal: %s\n", sprint.Goal)
+	}
+	return result
+}
+
+// sprintBoardID returns the board_id argument, or the board the sprint was
+// created on. Sprints whose origin board was deleted have none.
+func sprintBoardID(request mcp.CallToolRequest, sprint *models.SprintScheme) (int, error) {
+	if boardIDStr, ok := request.Params.Arguments["board_id"].(string); ok && boardIDStr != "" {
+		boardID, err := strconv.Atoi(boardIDStr)
+		if err != nil {
+			return 0, fmt.Errorf("invalid board_id: %v", err)
+		}
+		return boardID, nil
+	}
+	if sprint.OriginBoardID == 0 {
+		return 0, fmt.Errorf("sprint %d has no origin board, pass board_id", sprint.ID)
+	}
+	return sprint.OriginBoardID, nil
+}
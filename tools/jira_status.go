@@ -3,8 +3,10 @@ package tools
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/nguyenvanduocit/jira-mcp/services"
@@ -41,12 +43,114 @@ func jiraGetStatusesHandler(ctx context.Context, request mcp.CallToolRequest) (*
 
 	var result strings.Builder
 	result.WriteString("Available Statuses:\n")
+	byCategory := map[string][]string{}
 	for _, issueType := range issueTypes {
 		result.WriteString(fmt.Sprintf("\nIssue Type: %s\n", issueType.Name))
 		for _, status := range issueType.Statuses {
-			result.WriteString(fmt.Sprintf("  - %s: %s\n", status.Name, status.ID))
+			category := "new"
+			if status.StatusCategory != nil {
+				category = status.StatusCategory.Key
+			}
+			result.WriteString(fmt.Sprintf("  - %s: %s [%s]\n", status.Name, status.ID, statusCategoryName(category)))
+			if !slices.Contains(byCategory[category], status.Name) {
+				byCategory[category] = append(byCategory[category], status.Name)
+			}
+		}
+	}
+
+	result.WriteString("\nStatuses by category:\n")
+	for _, category := range statusCategoryOrder {
+		if len(byCategory[category]) > 0 {
+			result.WriteString(fmt.Sprintf("  - %s: %s\n", statusCategoryNames[category], strings.Join(byCategory[category], ", ")))
 		}
 	}
 
 	return mcp.NewToolResultText(result.String()), nil
 }
+
+// getStatusDetails returns every status of the instance, with its category,
+// keyed by status ID.
+func getStatusDetails(ctx context.Context) (map[string]*models.StatusDetailScheme, error) {
+	statuses, response, err := services.JiraClient().Workflow.Status.Bulk(ctx)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get statuses: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get statuses: %v", err)
+	}
+
+	details := make(map[string]*models.StatusDetailScheme, len(statuses))
+	for _, status := range statuses {
+		details[status.ID] = status
+	}
+	return details, nil
+}
+
+// statusCategoryOrder lists the status category keys in workflow order. Every
+// status belongs to one of them, whatever the project calls the status.
+var statusCategoryOrder = []string{"new", "indeterminate", "done"}
+
+var statusCategoryNames = map[string]string{
+	"new":           "To Do",
+	"indeterminate": "In Progress",
+	"done":          "Done",
+}
+
+// statusCategoryName returns the display name of a status category key.
+func statusCategoryName(key string) string {
+	if name, ok := statusCategoryNames[key]; ok {
+		return name
+	}
+	return statusCategoryNames["new"]
+}
+
+// normalizeStatusCategory returns a known category key, treating missing and
+// unknown categories, such as Jira's "undefined", as not started.
+func normalizeStatusCategory(key string) string {
+	if _, known := statusCategoryNames[key]; known {
+		return key
+	}
+	return "new"
+}
+
+// issueStatusCategory returns the status category key of an issue, treating
+// issues without a known one as not started.
+func issueStatusCategory(issue *models.IssueSchemeV2) string {
+	if issue.Fields.Status == nil || issue.Fields.Status.StatusCategory == nil {
+		return "new"
+	}
+	return normalizeStatusCategory(issue.Fields.Status.StatusCategory.Key)
+}
+
+// statusNormalizer maps status IDs, such as those found in changelogs, to
+// their names and categories, so analytics can treat "Doing", "In Dev" and
+// "WIP" alike. Search results carry their status category already and go
+// through issueStatusCategory instead, while the sprint report and burndown
+// count work as done by the board's done column, as Jira's own reports do.
+type statusNormalizer struct {
+	statuses map[string]*models.StatusDetailScheme
+}
+
+func newStatusNormalizer(ctx context.Context) (*statusNormalizer, error) {
+	statuses, err := getStatusDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &statusNormalizer{statuses: statuses}, nil
+}
+
+// category returns the category key of a status, "new" when it is unknown.
+func (n *statusNormalizer) category(statusID string) string {
+	if status, ok := n.statuses[statusID]; ok && status.StatusCategory != nil {
+		return normalizeStatusCategory(status.StatusCategory.Key)
+	}
+	return "new"
+}
+
+// name returns the display name of a status, or its ID when it is unknown.
+func (n *statusNormalizer) name(statusID string) string {
+	if status, ok := n.statuses[statusID]; ok {
+		return status.Name
+	}
+	return statusID
+}
@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+)
+
+// accountIDPattern matches Atlassian account IDs, both the legacy 24 character
+// hex form and the newer "<number>:<uuid>" form.
+var accountIDPattern = regexp.MustCompile(`^([0-9a-f]{24}|\d+:[0-9a-f-]{36}|qm:[0-9a-f-]+:[0-9a-f-]+)$`)
+
+// resolveUser finds a single user from an account ID, an email address, a
+// display name or the literal "me". Ambiguous or unknown names are reported as
+// errors listing the candidates so the caller can retry with an account ID.
+func resolveUser(ctx context.Context, identifier string) (*models.UserScheme, error) {
+	client := services.JiraClient()
+
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		return nil, fmt.Errorf("user identifier is empty")
+	}
+
+	if strings.EqualFold(identifier, "me") {
+		user, response, err := client.MySelf.Details(ctx, nil)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get current user: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get current user: %v", err)
+		}
+		return user, nil
+	}
+
+	if accountIDPattern.MatchString(identifier) {
+		user, response, err := client.User.Get(ctx, identifier, nil)
+		if err != nil {
+			if response != nil {
+				return nil, fmt.Errorf("failed to get user %s: %s (endpoint: %s)", identifier, response.Bytes.String(), response.Endpoint)
+			}
+			return nil, fmt.Errorf("failed to get user %s: %v", identifier, err)
+		}
+		return user, nil
+	}
+
+	users, response, err := client.User.Search.Do(ctx, "", identifier, 0, 50)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to search users: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to search users: %v", err)
+	}
+
+	var candidates []*models.UserScheme
+	for _, user := range users {
+		if user.Active && user.AccountType == "atlassian" {
+			candidates = append(candidates, user)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no active user found matching %q", identifier)
+	}
+
+	// Prefer exact matches so "Ann" does not become ambiguous with "Anna".
+	var exact []*models.UserScheme
+	for _, user := range candidates {
+		if strings.EqualFold(user.EmailAddress, identifier) || strings.EqualFold(user.DisplayName, identifier) {
+			exact = append(exact, user)
+		}
+	}
+	if len(exact) == 1 {
+		return exact[0], nil
+	}
+	if len(exact) > 1 {
+		candidates = exact
+	}
+
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%q matches %d users, retry with one of these account IDs:\n", identifier, len(candidates)))
+	for _, user := range candidates {
+		sb.WriteString(fmt.Sprintf("- %s (%s)\n", formatUser(user), user.AccountID))
+	}
+	return nil, fmt.Errorf("%s", sb.String())
+}
+
+// formatUser renders a user as "Display Name <email>", omitting the email when
+// the user's privacy settings hide it.
+func formatUser(user *models.UserScheme) string {
+	if user.EmailAddress != "" {
+		return fmt.Sprintf("%s <%s>", user.DisplayName, user.EmailAddress)
+	}
+	return user.DisplayName
+}
@@ -0,0 +1,360 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+// versionDateLayout is the date format of version start and release dates.
+const versionDateLayout = "2006-01-02"
+
+func RegisterJiraVersionTool(s *server.MCPServer) {
+	jiraListVersionsTool := mcp.NewTool("jira_list_versions",
+		mcp.WithDescription("List a project's versions (fix versions/releases) with their state, dates and issue progress"),
+		mcp.WithString("project_key", mcp.Required(), mcp.Description("Project identifier (e.g., KP, PROJ)")),
+		mcp.WithString("status", mcp.Description("Comma-separated version states to include: released, unreleased, archived (default: all)")),
+		mcp.WithString("query", mcp.Description("Only versions whose name or description contains this text")),
+		mcp.WithString("start_at", mcp.Description("Index of the first version to return, for pagination (default: 0)")),
+		mcp.WithString("max_results", mcp.Description("Maximum number of versions to return (default: 50)")),
+	)
+	s.AddTool(jiraListVersionsTool, util.ErrorGuard(jiraListVersionsHandler))
+
+	jiraCreateVersionTool := mcp.NewTool("jira_create_version",
+		mcp.WithDescription("Create a new version (fix version/release) in a project"),
+		mcp.WithString("project_key", mcp.Required(), mcp.Description("Project identifier (e.g., KP, PROJ)")),
+		mcp.WithString("name", mcp.Required(), mcp.Description("Name of the version (e.g., 1.4.0)")),
+		mcp.WithString("description", mcp.Description("Description of the version")),
+		mcp.WithString("start_date", mcp.Description("Start date, YYYY-MM-DD")),
+		mcp.WithString("release_date", mcp.Description("Planned release date, YYYY-MM-DD")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraCreateVersionTool, util.ErrorGuard(jiraCreateVersionHandler))
+	}
+
+	jiraUpdateVersionTool := mcp.NewTool("jira_update_version",
+		mcp.WithDescription("Update a version's name, description, dates or archived state. Only the given fields are changed"),
+		mcp.WithString("version_id", mcp.Required(), mcp.Description("ID of the version, from jira_list_versions")),
+		mcp.WithString("name", mcp.Description("New name of the version")),
+		mcp.WithString("description", mcp.Description("New description of the version")),
+		mcp.WithString("start_date", mcp.Description("New start date, YYYY-MM-DD")),
+		mcp.WithString("release_date", mcp.Description("New planned release date, YYYY-MM-DD")),
+		mcp.WithBoolean("archived", mcp.Description("Archive (true) or unarchive (false) the version")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraUpdateVersionTool, util.ErrorGuard(jiraUpdateVersionHandler))
+	}
+
+	jiraReleaseVersionTool := mcp.NewTool("jira_release_version",
+		mcp.WithDescription("Mark a version as released, optionally moving its unresolved issues to another version first"),
+		mcp.WithString("version_id", mcp.Required(), mcp.Description("ID of the version to release, from jira_list_versions")),
+		mcp.WithString("release_date", mcp.Description("Release date, YYYY-MM-DD (default: today)")),
+		mcp.WithString("move_unresolved_to", mcp.Description("Name or ID of a version in the same project that receives the version's unresolved issues")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraReleaseVersionTool, util.ErrorGuard(jiraReleaseVersionHandler))
+	}
+}
+
+func jiraListVersionsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	projectKey, ok := request.Params.Arguments["project_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("project_key argument is required")
+	}
+
+	startAt, err := intArgument(request, "start_at", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults, err := positiveIntArgument(request, "max_results", 50)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &models.VersionGetsOptions{
+		OrderBy: "-sequence",
+		Expand:  []string{"issuesstatus"},
+	}
+	if query, ok := request.Params.Arguments["query"].(string); ok {
+		options.Query = query
+	}
+	if status, ok := request.Params.Arguments["status"].(string); ok && status != "" {
+		states := splitList(status)
+		for _, state := range states {
+			switch state {
+			case "released", "unreleased", "archived":
+			default:
+				return nil, fmt.Errorf("invalid version status %q, expected released, unreleased or archived", state)
+			}
+		}
+		options.Status = strings.Join(states, ",")
+	}
+
+	versions, response, err := client.Project.Version.Search(ctx, projectKey, options, startAt, maxResults)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get versions: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get versions: %v", err)
+	}
+
+	if len(versions.Values) == 0 {
+		return mcp.NewToolResultText("No versions found matching the criteria."), nil
+	}
+
+	var sb strings.Builder
+	for _, version := range versions.Values {
+		sb.WriteString(formatVersion(version))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("Showing %d-%d of %d versions", startAt+1, startAt+len(versions.Values), versions.Total))
+	if !versions.IsLast {
+		sb.WriteString(fmt.Sprintf(", use start_at=%d for the next page", startAt+len(versions.Values)))
+	}
+	sb.WriteString("\n")
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func jiraCreateVersionHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	projectKey, ok := request.Params.Arguments["project_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("project_key argument is required")
+	}
+
+	name, ok := request.Params.Arguments["name"].(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("name argument is required")
+	}
+
+	project, response, err := client.Project.Get(ctx, projectKey, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get project: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get project: %v", err)
+	}
+
+	projectID, err := strconv.Atoi(project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project id %q: %v", project.ID, err)
+	}
+
+	payload := &models.VersionPayloadScheme{Name: name, ProjectID: projectID}
+	if description, ok := request.Params.Arguments["description"].(string); ok {
+		payload.Description = description
+	}
+	if payload.StartDate, err = versionDateArgument(request, "start_date"); err != nil {
+		return nil, err
+	}
+	if payload.ReleaseDate, err = versionDateArgument(request, "release_date"); err != nil {
+		return nil, err
+	}
+
+	version, response, err := client.Project.Version.Create(ctx, payload)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to create version: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to create version: %v", err)
+	}
+
+	return mcp.NewToolResultText("Version created successfully!\n\n" + formatVersion(version)), nil
+}
+
+func jiraUpdateVersionHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	versionID, ok := request.Params.Arguments["version_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("version_id argument is required")
+	}
+
+	// The SDK payload omits false booleans, so unarchiving needs a plain map.
+	payload := map[string]interface{}{}
+	for _, name := range []string{"name", "description"} {
+		if value, ok := request.Params.Arguments[name].(string); ok && value != "" {
+			payload[name] = value
+		}
+	}
+	for argument, field := range map[string]string{"start_date": "startDate", "release_date": "releaseDate"} {
+		date, err := versionDateArgument(request, argument)
+		if err != nil {
+			return nil, err
+		}
+		if date != "" {
+			payload[field] = date
+		}
+	}
+	if archived, ok := request.Params.Arguments["archived"].(bool); ok {
+		payload["archived"] = archived
+	}
+
+	if len(payload) == 0 {
+		return nil, fmt.Errorf("at least one of name, description, start_date, release_date or archived is required")
+	}
+
+	version, err := updateVersion(ctx, versionID, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText("Version updated successfully!\n\n" + formatVersion(version)), nil
+}
+
+func jiraReleaseVersionHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	versionID, ok := request.Params.Arguments["version_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("version_id argument is required")
+	}
+
+	releaseDate, err := versionDateArgument(request, "release_date")
+	if err != nil {
+		return nil, err
+	}
+	if releaseDate == "" {
+		releaseDate = time.Now().Format(versionDateLayout)
+	}
+
+	version, response, err := client.Project.Version.Get(ctx, versionID, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get version: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get version: %v", err)
+	}
+
+	if version.Released {
+		return nil, fmt.Errorf("version %s is already released", version.Name)
+	}
+
+	unresolved, response, err := client.Project.Version.UnresolvedIssueCount(ctx, versionID)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to count unresolved issues: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to count unresolved issues: %v", err)
+	}
+
+	payload := map[string]interface{}{"released": true, "releaseDate": releaseDate}
+
+	var target *models.VersionScheme
+	if moveTo, ok := request.Params.Arguments["move_unresolved_to"].(string); ok && moveTo != "" {
+		target, err = findVersion(ctx, strconv.Itoa(version.ProjectID), moveTo)
+		if err != nil {
+			return nil, err
+		}
+		if target.ID == version.ID {
+			return nil, fmt.Errorf("move_unresolved_to must be a different version than the one being released")
+		}
+		if target.Released {
+			return nil, fmt.Errorf("version %s is already released, move unresolved issues to an unreleased version", target.Name)
+		}
+		// Jira moves the unfixed issues as part of the release update.
+		payload["moveUnfixedIssuesTo"] = target.Self
+	}
+
+	released, err := updateVersion(ctx, versionID, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Version %s released on %s.\n", released.Name, releaseDate))
+	switch {
+	case target != nil && unresolved.IssuesUnresolvedCount > 0:
+		sb.WriteString(fmt.Sprintf("Moved %d unresolved issues to %s.\n", unresolved.IssuesUnresolvedCount, target.Name))
+	case unresolved.IssuesUnresolvedCount > 0:
+		sb.WriteString(fmt.Sprintf("Warning: %d of %d issues in this version are still unresolved.\n", unresolved.IssuesUnresolvedCount, unresolved.IssuesCount))
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// updateVersion sends a partial version update. Jira only changes the fields
+// present in the payload.
+func updateVersion(ctx context.Context, versionID string, payload map[string]interface{}) (*models.VersionScheme, error) {
+	client := services.JiraClient()
+
+	updateRequest, err := client.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/2/version/%s", versionID), "", payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create version update request: %v", err)
+	}
+
+	version := new(models.VersionScheme)
+	response, err := client.Call(updateRequest, version)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to update version: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to update version: %v", err)
+	}
+
+	return version, nil
+}
+
+// findVersion resolves a version of a project given by ID or by name.
+func findVersion(ctx context.Context, projectKeyOrID, nameOrID string) (*models.VersionScheme, error) {
+	versions, response, err := services.JiraClient().Project.Version.Gets(ctx, projectKeyOrID)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get versions: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get versions: %v", err)
+	}
+
+	for _, version := range versions {
+		if version.ID == nameOrID {
+			return version, nil
+		}
+	}
+	for _, version := range versions {
+		if strings.EqualFold(version.Name, nameOrID) {
+			return version, nil
+		}
+	}
+
+	return nil, fmt.Errorf("version %q not found in project %s", nameOrID, projectKeyOrID)
+}
+
+// versionDateArgument reads an optional YYYY-MM-DD date argument.
+func versionDateArgument(request mcp.CallToolRequest, name string) (string, error) {
+	value, ok := request.Params.Arguments[name].(string)
+	if !ok || value == "" {
+		return "", nil
+	}
+	if _, err := time.Parse(versionDateLayout, value); err != nil {
+		return "", fmt.Errorf("invalid %s, expected YYYY-MM-DD: %v", name, err)
+	}
+	return value, nil
+}
+
+func formatVersion(version *models.VersionScheme) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("ID: %s\nName: %s\nState: %s\n", version.ID, version.Name, versionState(version)))
+	if version.ReleaseDate != "" {
+		sb.WriteString(fmt.Sprintf("Release date: %s\n", version.ReleaseDate))
+	}
+	if version.Description != "" {
+		sb.WriteString(fmt.Sprintf("Description: %s\n", version.Description))
+	}
+	if status := version.IssuesStatusForFixVersion; status != nil {
+		sb.WriteString(fmt.Sprintf("Issues: %d to do, %d in progress, %d done\n", status.ToDo+status.Unmapped, status.InProgress, status.Done))
+	}
+	return sb.String()
+}
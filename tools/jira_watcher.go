@@ -0,0 +1,193 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraWatcherTool(s *server.MCPServer) {
+	jiraGetWatchersTool := mcp.NewTool("jira_get_watchers",
+		mcp.WithDescription("List the users watching a Jira issue, along with the issue's vote count"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+	)
+	s.AddTool(jiraGetWatchersTool, util.ErrorGuard(jiraGetWatchersHandler))
+
+	jiraAddWatcherTool := mcp.NewTool("jira_add_watcher",
+		mcp.WithDescription("Add a user as a watcher of a Jira issue so they are notified of changes"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+		mcp.WithString("user", mcp.Required(), mcp.Description("Account ID, email, display name, or \"me\" for the authenticated user")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraAddWatcherTool, util.ErrorGuard(jiraAddWatcherHandler))
+	}
+
+	jiraRemoveWatcherTool := mcp.NewTool("jira_remove_watcher",
+		mcp.WithDescription("Remove a user from the watchers of a Jira issue"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+		mcp.WithString("user", mcp.Required(), mcp.Description("Account ID, email, display name, or \"me\" for the authenticated user")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraRemoveWatcherTool, util.ErrorGuard(jiraRemoveWatcherHandler))
+	}
+
+	jiraVoteIssueTool := mcp.NewTool("jira_vote_issue",
+		mcp.WithDescription("Cast or withdraw the authenticated user's vote on a Jira issue"),
+		mcp.WithString("issue_key", mcp.Required(), mcp.Description("The unique identifier of the Jira issue (e.g., KP-2, PROJ-123)")),
+		mcp.WithBoolean("remove", mcp.Description("Withdraw the vote instead of casting it (default: false)")),
+	)
+	if !util.IsReadOnly() {
+		s.AddTool(jiraVoteIssueTool, util.ErrorGuard(jiraVoteIssueHandler))
+	}
+}
+
+func jiraGetWatchersHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	watchers, response, err := client.Issue.Watcher.Gets(ctx, issueKey)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get watchers: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get watchers: %v", err)
+	}
+
+	votes, response, err := client.Issue.Vote.Gets(ctx, issueKey)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get votes: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get votes: %v", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Issue: %s\nWatchers: %d\nYou are watching: %t\nVotes: %d\nYou have voted: %t\n",
+		issueKey, watchers.WatchCount, watchers.IsWatching, votes.Votes, votes.HasVoted))
+
+	if len(watchers.Watchers) > 0 {
+		sb.WriteString("\nWatching:\n")
+		for _, watcher := range watchers.Watchers {
+			// Watchers come back as user details, which formatUser does not take.
+			user := &models.UserScheme{DisplayName: watcher.DisplayName, EmailAddress: watcher.EmailAddress}
+			sb.WriteString(fmt.Sprintf("- %s (%s)\n", formatUser(user), watcher.AccountID))
+		}
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func jiraAddWatcherHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	userArg, ok := request.Params.Arguments["user"].(string)
+	if !ok {
+		return nil, fmt.Errorf("user argument is required")
+	}
+
+	user, err := resolveUser(ctx, userArg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The SDK's Watchers.Add only adds the calling user, so post the account ID directly.
+	watchRequest, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/2/issue/%s/watchers", issueKey), "", user.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create watcher request: %v", err)
+	}
+
+	response, err := client.Call(watchRequest, nil)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to add watcher: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to add watcher: %v", err)
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("%s is now watching %s", formatUser(user), issueKey)), nil
+}
+
+func jiraRemoveWatcherHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	userArg, ok := request.Params.Arguments["user"].(string)
+	if !ok {
+		return nil, fmt.Errorf("user argument is required")
+	}
+
+	user, err := resolveUser(ctx, userArg)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Issue.Watcher.Delete(ctx, issueKey, user.AccountID)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to remove watcher: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to remove watcher: %v", err)
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("%s is no longer watching %s", formatUser(user), issueKey)), nil
+}
+
+func jiraVoteIssueHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	issueKey, ok := request.Params.Arguments["issue_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("issue_key argument is required")
+	}
+
+	remove, _ := request.Params.Arguments["remove"].(bool)
+
+	var response *models.ResponseScheme
+	var err error
+	if remove {
+		response, err = client.Issue.Vote.Delete(ctx, issueKey)
+	} else {
+		response, err = client.Issue.Vote.Add(ctx, issueKey)
+	}
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to update vote: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to update vote: %v", err)
+	}
+
+	votes, response, err := client.Issue.Vote.Gets(ctx, issueKey)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get votes: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get votes: %v", err)
+	}
+
+	verb := "Vote added to"
+	if remove {
+		verb = "Vote removed from"
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("%s %s\nVotes: %d", verb, issueKey, votes.Votes)), nil
+}
@@ -0,0 +1,259 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/jira-mcp/services"
+	"github.com/nguyenvanduocit/jira-mcp/util"
+)
+
+func RegisterJiraWorkflowTool(s *server.MCPServer) {
+	jiraGetWorkflowTool := mcp.NewTool("jira_get_workflow",
+		mcp.WithDescription("Retrieve the workflow an issue type uses in a project: statuses with their categories, transitions with from/to statuses and transition screens, as text and as a Mermaid state diagram. Requires Jira administrator permission"),
+		mcp.WithString("project_key", mcp.Required(), mcp.Description("Project identifier (e.g., KP, PROJ)")),
+		mcp.WithString("issue_type", mcp.Description("Issue type name (e.g., Bug). Default: the project's default workflow")),
+	)
+	s.AddTool(jiraGetWorkflowTool, util.ErrorGuard(jiraGetWorkflowHandler))
+}
+
+// workflowSchemeAssociationPage is the project workflow scheme response. The
+// SDK model drops issueTypeMappings, so it is decoded from the raw body.
+type workflowSchemeAssociationPage struct {
+	Values []struct {
+		WorkflowScheme struct {
+			Name              string            `json:"name"`
+			DefaultWorkflow   string            `json:"defaultWorkflow"`
+			IssueTypeMappings map[string]string `json:"issueTypeMappings"`
+		} `json:"workflowScheme"`
+	} `json:"values"`
+}
+
+func jiraGetWorkflowHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client := services.JiraClient()
+
+	projectKey, ok := request.Params.Arguments["project_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("project_key argument is required")
+	}
+
+	issueTypeName, _ := request.Params.Arguments["issue_type"].(string)
+
+	project, response, err := client.Project.Get(ctx, projectKey, []string{"issueTypes"})
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get project: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get project: %v", err)
+	}
+
+	projectID, err := strconv.Atoi(project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project id %q: %v", project.ID, err)
+	}
+
+	_, response, err = client.Workflow.Scheme.Associations(ctx, []int{projectID})
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get workflow scheme: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get workflow scheme: %v", err)
+	}
+
+	associations := new(workflowSchemeAssociationPage)
+	if err := json.Unmarshal(response.Bytes.Bytes(), associations); err != nil {
+		return nil, fmt.Errorf("failed to decode workflow scheme: %v", err)
+	}
+	if len(associations.Values) == 0 {
+		return nil, fmt.Errorf("project %s has no workflow scheme, team-managed projects keep their workflow per issue type, use jira_list_statuses instead", project.Key)
+	}
+	scheme := associations.Values[0].WorkflowScheme
+
+	workflowName := scheme.DefaultWorkflow
+	var issueType *models.IssueTypeScheme
+	if issueTypeName != "" {
+		var names []string
+		for _, candidate := range project.IssueTypes {
+			if strings.EqualFold(candidate.Name, issueTypeName) {
+				issueType = candidate
+			}
+			names = append(names, candidate.Name)
+		}
+		if issueType == nil {
+			return nil, fmt.Errorf("issue type %q not found in project %s, available issue types: %s", issueTypeName, project.Key, strings.Join(names, ", "))
+		}
+		if mapped, ok := scheme.IssueTypeMappings[issueType.ID]; ok {
+			workflowName = mapped
+		}
+	}
+	// Schemes without a default fall back to Jira's built-in workflow.
+	if workflowName == "" {
+		workflowName = "jira"
+	}
+
+	workflows, response, err := client.Workflow.Gets(ctx, &models.WorkflowSearchOptions{
+		WorkflowName: []string{workflowName},
+		Expand:       []string{"transitions", "statuses"},
+	}, 0, 1)
+	if err != nil {
+		if response != nil {
+			return nil, fmt.Errorf("failed to get workflow: %s (endpoint: %s)", response.Bytes.String(), response.Endpoint)
+		}
+		return nil, fmt.Errorf("failed to get workflow: %v", err)
+	}
+	if len(workflows.Values) == 0 {
+		return nil, fmt.Errorf("workflow %q not found", workflowName)
+	}
+	workflow := workflows.Values[0]
+
+	statusDetails, err := getStatusDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	screenNames := workflowScreenNames(ctx, workflow.Transitions)
+
+	statusName := func(id string) string {
+		for _, status := range workflow.Statuses {
+			if status.ID == id {
+				return status.Name
+			}
+		}
+		if detail, ok := statusDetails[id]; ok {
+			return detail.Name
+		}
+		return id
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Workflow: %s\nWorkflow scheme: %s\n", workflowName, scheme.Name))
+	if workflow.Description != "" {
+		sb.WriteString(fmt.Sprintf("Description: %s\n", workflow.Description))
+	}
+
+	// List the issue types sharing this workflow so callers know its reach.
+	var usedBy []string
+	for _, candidate := range project.IssueTypes {
+		mapped, ok := scheme.IssueTypeMappings[candidate.ID]
+		if (ok && mapped == workflowName) || (!ok && workflowName == scheme.DefaultWorkflow) {
+			usedBy = append(usedBy, candidate.Name)
+		}
+	}
+	if len(usedBy) > 0 {
+		sb.WriteString(fmt.Sprintf("Used by issue types: %s\n", strings.Join(usedBy, ", ")))
+	}
+
+	sb.WriteString("\nStatuses:\n")
+	for _, status := range workflow.Statuses {
+		category := "Unknown"
+		if detail, ok := statusDetails[status.ID]; ok && detail.StatusCategory != nil {
+			category = detail.StatusCategory.Name
+		}
+		sb.WriteString(fmt.Sprintf("- %s (ID: %s) [%s]\n", status.Name, status.ID, category))
+	}
+
+	sb.WriteString("\nTransitions:\n")
+	for _, transition := range workflow.Transitions {
+		var from string
+		switch {
+		case transition.Type == "initial":
+			from = "(create)"
+		case transition.Type == "global" || len(transition.From) == 0:
+			from = "any status"
+		default:
+			var names []string
+			for _, id := range transition.From {
+				names = append(names, statusName(id))
+			}
+			from = strings.Join(names, ", ")
+		}
+
+		line := fmt.Sprintf("- %s (ID: %s): %s → %s", transition.Name, transition.ID, from, statusName(transition.To))
+		if transition.Screen != nil && transition.Screen.ID != "" {
+			screen := screenNames[transition.Screen.ID]
+			if screen == "" {
+				screen = "ID " + transition.Screen.ID
+			}
+			line += fmt.Sprintf(" [screen: %s]", screen)
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	sb.WriteString("\n```mermaid\n" + workflowMermaid(workflow, statusName) + "```\n")
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// workflowScreenNames looks up the names of the screens shown by the
+// transitions. Screens are only decoration, so lookup failures are ignored
+// and the screen ID is shown instead.
+func workflowScreenNames(ctx context.Context, transitions []*models.WorkflowTransitionScheme) map[string]string {
+	names := map[string]string{}
+
+	var ids []int
+	for _, transition := range transitions {
+		if transition.Screen == nil {
+			continue
+		}
+		if id, err := strconv.Atoi(transition.Screen.ID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return names
+	}
+
+	screens, _, err := services.JiraClient().Screen.Gets(ctx, &models.ScreenParamsScheme{IDs: ids}, 0, len(ids))
+	if err != nil {
+		return names
+	}
+	for _, screen := range screens.Values {
+		names[strconv.Itoa(screen.ID)] = screen.Name
+	}
+	return names
+}
+
+// workflowMermaid renders a workflow as a Mermaid state diagram. Global
+// transitions start from an "Any status" pseudo-state, like Jira's own
+// "All" marker.
+func workflowMermaid(workflow *models.WorkflowScheme, statusName func(id string) string) string {
+	var sb strings.Builder
+	sb.WriteString("stateDiagram-v2\n")
+
+	for _, status := range workflow.Statuses {
+		sb.WriteString(fmt.Sprintf("    state \"%s\" as s%s\n", mermaidLabel(statusName(status.ID)), status.ID))
+	}
+	for _, transition := range workflow.Transitions {
+		if transition.Type != "initial" && (transition.Type == "global" || len(transition.From) == 0) {
+			sb.WriteString("    state \"Any status\" as any\n")
+			break
+		}
+	}
+
+	for _, transition := range workflow.Transitions {
+		label := mermaidLabel(transition.Name)
+		switch {
+		case transition.Type == "initial":
+			sb.WriteString(fmt.Sprintf("    [*] --> s%s : %s\n", transition.To, label))
+		case transition.Type == "global" || len(transition.From) == 0:
+			sb.WriteString(fmt.Sprintf("    any --> s%s : %s\n", transition.To, label))
+		default:
+			for _, from := range transition.From {
+				sb.WriteString(fmt.Sprintf("    s%s --> s%s : %s\n", from, transition.To, label))
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// mermaidLabel strips the characters that end a Mermaid label or state name.
+func mermaidLabel(value string) string {
+	return strings.NewReplacer(`"`, "'", ":", " ", "\n", " ").Replace(value)
+}